@@ -0,0 +1,9 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+action "binarylane_server_power_off" "example" {
+  config {
+    server_id = binarylane_server.example.id
+  }
+}
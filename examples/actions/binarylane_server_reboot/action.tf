@@ -0,0 +1,21 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+action "binarylane_server_reboot" "example" {
+  config {
+    server_id = binarylane_server.example.id
+  }
+}
+
+# Reboot the server whenever the cloud-init configuration is changed
+resource "terraform_data" "example" {
+  input = binarylane_server.example.user_data
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.binarylane_server_reboot.example]
+    }
+  }
+}
@@ -0,0 +1,12 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+action "binarylane_server_take_backup" "example" {
+  config {
+    server_id            = binarylane_server.example.id
+    replacement_strategy = "oldest"
+    backup_type          = "temporary"
+    label                = "before-upgrade"
+  }
+}
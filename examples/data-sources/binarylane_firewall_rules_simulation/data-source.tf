@@ -0,0 +1,49 @@
+resource "binarylane_server_firewall_rules" "example" {
+  server_id = binarylane_server.example.id
+  firewall_rules = [
+    {
+      description           = "Allow SSH from the office"
+      protocol              = "tcp"
+      source_addresses      = ["203.0.113.0/24"]
+      destination_addresses = binarylane_server.example.public_ipv4_addresses
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+    {
+      description           = "Block SSH"
+      protocol              = "tcp"
+      source_addresses      = ["0.0.0.0/0"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "drop"
+    },
+  ]
+}
+
+check "ssh_from_internet_is_dropped" {
+  data "binarylane_firewall_rules_simulation" "ssh" {
+    firewall_rules = binarylane_server_firewall_rules.example.firewall_rules
+    probes = [
+      {
+        protocol         = "tcp"
+        source_address   = "198.51.100.1"
+        destination_port = 22
+      },
+      {
+        protocol         = "tcp"
+        source_address   = "203.0.113.10"
+        destination_port = 22
+      },
+    ]
+  }
+
+  assert {
+    condition     = data.binarylane_firewall_rules_simulation.ssh.results[0].action == "drop"
+    error_message = "SSH from the internet must be dropped."
+  }
+
+  assert {
+    condition     = data.binarylane_firewall_rules_simulation.ssh.results[1].action == "accept"
+    error_message = "SSH from the office must be accepted."
+  }
+}
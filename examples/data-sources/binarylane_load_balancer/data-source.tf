@@ -1,3 +1,7 @@
 data "binarylane_load_balancer" "example" {
   id = 123456
 }
+
+data "binarylane_load_balancer" "example_by_name" {
+  name = "example-load-balancer"
+}
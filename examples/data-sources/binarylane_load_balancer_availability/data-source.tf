@@ -0,0 +1,8 @@
+data "binarylane_load_balancer_availability" "example" {
+}
+
+output "load_balancer_regions" {
+  value = flatten([
+    for option in data.binarylane_load_balancer_availability.example.options : coalesce(option.regions, [])
+  ])
+}
@@ -0,0 +1,8 @@
+data "binarylane_load_balancers" "example" {
+  name_regex = "^ingress-prod-.*$"
+  region     = "per"
+}
+
+output "ingress_ips" {
+  value = data.binarylane_load_balancers.example.load_balancers[*].ip
+}
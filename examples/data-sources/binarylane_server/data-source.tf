@@ -1,3 +1,7 @@
 data "binarylane_server" "example" {
   id = 123456
 }
+
+data "binarylane_server" "example_by_name" {
+  name = "vps01.example.com"
+}
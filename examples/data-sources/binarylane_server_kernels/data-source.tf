@@ -0,0 +1,3 @@
+data "binarylane_server_kernels" "example" {
+  server_id = 123456
+}
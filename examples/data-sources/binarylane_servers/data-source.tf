@@ -0,0 +1,9 @@
+data "binarylane_servers" "example" {
+  name_regex  = "^web-[0-9]+$"
+  region      = "per"
+  power_state = "running"
+}
+
+output "web_server_ips" {
+  value = flatten(data.binarylane_servers.example.servers[*].public_ipv4_addresses)
+}
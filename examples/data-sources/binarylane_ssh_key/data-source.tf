@@ -1,3 +1,11 @@
 data "binarylane_ssh_key" "example" {
   id = 123456
 }
+
+data "binarylane_ssh_key" "example_by_name" {
+  name = "example-key"
+}
+
+data "binarylane_ssh_key" "example_by_fingerprint" {
+  fingerprint = "a1:b2:c3:d4:e5:f6:a7:b8:c9:d0:e1:f2:a3:b4:c5:d6"
+}
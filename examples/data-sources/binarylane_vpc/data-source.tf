@@ -1,3 +1,7 @@
 data "binarylane_vpc" "example" {
   id = 123456
 }
+
+data "binarylane_vpc" "example_by_name" {
+  name = "example-vpc"
+}
@@ -0,0 +1,7 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+ephemeral "binarylane_server_console" "example" {
+  server_id = binarylane_server.example.id
+}
@@ -0,0 +1,8 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+ephemeral "binarylane_server_password" "example" {
+  server_id = binarylane_server.example.id
+  username  = "root"
+}
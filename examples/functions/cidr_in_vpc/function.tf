@@ -0,0 +1,26 @@
+resource "binarylane_vpc" "example" {
+  name     = "example"
+  ip_range = "10.240.0.0/16"
+}
+
+variable "router" {
+  type = string
+}
+
+resource "binarylane_vpc_route_entries" "example" {
+  vpc_id = binarylane_vpc.example.id
+  route_entries = [
+    {
+      router      = var.router
+      destination = "0.0.0.0/0"
+      description = "Default route"
+    },
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = provider::binarylane::cidr_in_vpc(var.router, binarylane_vpc.example.ip_range)
+      error_message = "The router must be within the IP range of the VPC."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+resource "binarylane_server" "example" {
+  # ...
+  disk = provider::binarylane::round_disk(64) # 70
+}
@@ -0,0 +1,9 @@
+variable "memory" {
+  type    = string
+  default = "2.5 GB"
+}
+
+resource "binarylane_server" "example" {
+  # ...
+  memory = provider::binarylane::round_memory(var.memory) # 3072
+}
@@ -0,0 +1,8 @@
+variable "disk" {
+  type = number
+
+  validation {
+    condition     = provider::binarylane::valid_disk(var.disk)
+    error_message = "Not a valid amount of disk for a BinaryLane server."
+  }
+}
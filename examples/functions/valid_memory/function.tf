@@ -0,0 +1,8 @@
+variable "memory" {
+  type = number
+
+  validation {
+    condition     = provider::binarylane::valid_memory(var.memory)
+    error_message = "Not a valid amount of memory for a BinaryLane server."
+  }
+}
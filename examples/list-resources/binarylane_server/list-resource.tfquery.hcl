@@ -0,0 +1,8 @@
+# Run `terraform query -generate-config-out=generated.tf` to generate import blocks and configuration
+list "binarylane_server" "all" {
+  provider = binarylane
+
+  config {
+    region = "per"
+  }
+}
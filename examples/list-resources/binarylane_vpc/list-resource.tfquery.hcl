@@ -0,0 +1,4 @@
+# Run `terraform query -generate-config-out=generated.tf` to generate import blocks and configuration
+list "binarylane_vpc" "all" {
+  provider = binarylane
+}
@@ -0,0 +1,30 @@
+resource "binarylane_server" "web" {
+  count = 3
+  # ...
+}
+
+resource "binarylane_firewall_policy" "web" {
+  name       = "web"
+  server_ids = binarylane_server.web[*].id
+  firewall_rules = [
+    {
+      description           = "Allow SSH from HQ"
+      protocol              = "tcp"
+      source_addresses      = ["203.0.113.0/24"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+    {
+      description           = "Block SSH"
+      protocol              = "tcp"
+      source_addresses      = ["0.0.0.0/0"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "drop"
+    },
+  ]
+
+  # Keep server specific rules, e.g. from binarylane_server_firewall_rule resources
+  merge_existing_rules = true
+}
@@ -0,0 +1 @@
+terraform import binarylane_load_balancer_attachment.example "<load_balancer_id>/<server_id>,<server_id>"
@@ -0,0 +1,17 @@
+resource "binarylane_load_balancer" "example" {
+  name   = "example-load-balancer"
+  region = "per"
+
+  # Servers are assigned by binarylane_load_balancer_attachment resources
+  ignore_server_ids = true
+}
+
+resource "binarylane_server" "web" {
+  count = 2
+  # ...
+}
+
+resource "binarylane_load_balancer_attachment" "web" {
+  load_balancer_id = binarylane_load_balancer.example.id
+  server_ids       = binarylane_server.web[*].id
+}
@@ -0,0 +1 @@
+terraform import binarylane_load_balancer_forwarding_rule.example "<load_balancer_id>/<entry_protocol>"
@@ -0,0 +1,14 @@
+resource "binarylane_load_balancer" "example" {
+  name   = "example-load-balancer"
+  region = "per"
+
+  # Forwarding rules are managed by binarylane_load_balancer_forwarding_rule resources
+  lifecycle {
+    ignore_changes = [forwarding_rules]
+  }
+}
+
+resource "binarylane_load_balancer_forwarding_rule" "https" {
+  load_balancer_id = binarylane_load_balancer.example.id
+  entry_protocol   = "https"
+}
@@ -0,0 +1 @@
+terraform import binarylane_server_disk.example "<server_id>/<disk_id>"
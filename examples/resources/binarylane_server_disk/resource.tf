@@ -0,0 +1,9 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+resource "binarylane_server_disk" "example" {
+  server_id      = binarylane_server.example.id
+  size_gigabytes = 20
+  description    = "Database data"
+}
@@ -0,0 +1 @@
+terraform import binarylane_server_firewall_rule.example "<server_id>/<description>"
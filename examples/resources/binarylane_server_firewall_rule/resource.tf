@@ -0,0 +1,24 @@
+resource "binarylane_server" "example" {
+  # ...
+}
+
+# Rules can be managed separately, e.g. by different modules
+resource "binarylane_server_firewall_rule" "ssh" {
+  server_id             = binarylane_server.example.id
+  description           = "Allow SSH from HQ"
+  protocol              = "tcp"
+  source_addresses      = ["203.0.113.0/24"]
+  destination_addresses = binarylane_server.example.public_ipv4_addresses
+  destination_ports     = ["22"]
+  action                = "accept"
+}
+
+resource "binarylane_server_firewall_rule" "https" {
+  server_id             = binarylane_server.example.id
+  description           = "Allow HTTPS"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = binarylane_server.example.public_ipv4_addresses
+  destination_ports     = ["443"]
+  action                = "accept"
+}
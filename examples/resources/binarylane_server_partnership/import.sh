@@ -0,0 +1 @@
+terraform import binarylane_server_partnership.example "<server_id>"
@@ -0,0 +1,12 @@
+resource "binarylane_server" "primary" {
+  # ...
+}
+
+resource "binarylane_server" "secondary" {
+  # ...
+}
+
+resource "binarylane_server_partnership" "example" {
+  server_id         = binarylane_server.primary.id
+  partner_server_id = binarylane_server.secondary.id
+}
@@ -0,0 +1 @@
+terraform import binarylane_vpc_route.example "<vpc_id>/<destination>"
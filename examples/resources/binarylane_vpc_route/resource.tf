@@ -0,0 +1,17 @@
+resource "binarylane_vpc" "example" {
+  name     = "tf-example-vpc"
+  ip_range = "10.240.0.0/16"
+}
+
+resource "binarylane_server" "vpn" {
+  # ...
+  vpc_id = binarylane_vpc.example.id
+}
+
+# Route entries of the same VPC may be managed separately, e.g. by different teams
+resource "binarylane_vpc_route" "vpn" {
+  vpc_id      = binarylane_vpc.example.id
+  description = "VPN"
+  destination = "192.168.1.0/24"
+  router      = binarylane_server.vpn.private_ipv4_addresses.0
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &cidrInVpcFunction{}
+)
+
+func NewCidrInVpcFunction() function.Function {
+	return &cidrInVpcFunction{}
+}
+
+type cidrInVpcFunction struct{}
+
+func (f *cidrInVpcFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_in_vpc"
+}
+
+func (f *cidrInVpcFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a CIDR block is within the IP range of a VPC",
+		MarkdownDescription: "Returns `true` if every address in the CIDR block is within the IP range of the VPC, " +
+			"otherwise `false`. Useful for validating the `router` of `binarylane_vpc_route_entries`, or for checking " +
+			"whether a `destination` overlaps the VPC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The CIDR block or IP address to check, e.g. `10.240.1.0/24` or `10.240.0.5`.",
+			},
+			function.StringParameter{
+				Name:                "vpc_range",
+				MarkdownDescription: "The IP range of the VPC in CIDR notation, e.g. `10.240.0.0/16`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrInVpcFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, vpcRange string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr, &vpcRange))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parsePrefixOrAddr(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid CIDR block: %s", err))
+		return
+	}
+	vpcPrefix, err := netip.ParsePrefix(vpcRange)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid VPC range: %s", err))
+		return
+	}
+
+	contained := vpcPrefix.Masked().Contains(prefix.Addr()) && prefix.Bits() >= vpcPrefix.Bits()
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, contained))
+}
+
+// parsePrefixOrAddr parses a CIDR block, or a single IP address as a prefix containing only that address.
+func parsePrefixOrAddr(s string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCidrInVpcFunction(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+output "subnet" {
+	value = provider::binarylane::cidr_in_vpc("10.240.1.0/24", "10.240.0.0/16")
+}
+
+output "address" {
+	value = provider::binarylane::cidr_in_vpc("10.240.0.5", "10.240.0.0/16")
+}
+
+output "larger" {
+	value = provider::binarylane::cidr_in_vpc("10.0.0.0/8", "10.240.0.0/16")
+}
+
+output "outside" {
+	value = provider::binarylane::cidr_in_vpc("192.168.0.0/24", "10.240.0.0/16")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("subnet", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("address", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("larger", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("outside", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: providerConfig + `
+output "invalid" {
+	value = provider::binarylane::cidr_in_vpc("10.240.0.0/33", "10.240.0.0/16")
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid CIDR block"),
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// lookupId returns the ID of the only item for which match returns true, so that a data source can be looked up by
+// an attribute other than "id". An error is added to the lookup attribute if there is not exactly one match.
+func lookupId[T any](
+	items []T, getId func(T) int64, match func(T) bool, kind string, attribute string, value string,
+) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var ids []string
+	var id int64
+	for _, item := range items {
+		if match(item) {
+			id = getId(item)
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Could not find %s by %s", kind, attribute),
+			fmt.Sprintf("No %s was found with %s=%s.", kind, attribute, value),
+		)
+	case 1:
+		return id, diags
+	default:
+		diags.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Multiple matches found for %s by %s", kind, attribute),
+			fmt.Sprintf("Found %d matches for %s with %s=%s: id=%s. Use \"id\" to select a single %s.",
+				len(ids), kind, attribute, value, strings.Join(ids, ", id="), kind),
+		)
+	}
+	return 0, diags
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FirewallAddressType
+
+// Ensure the implementation satisfies the expected interfaces
+var _ basetypes.StringTypable = FirewallAddressType{}
+
+// FirewallAddressType is an IPv4 address or CIDR block of a firewall rule, which is equal to any other address or
+// CIDR block for the same range, e.g. "10.0.0.1" and "10.0.0.1/32".
+type FirewallAddressType struct {
+	basetypes.StringType
+}
+
+func (t FirewallAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(FirewallAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t FirewallAddressType) String() string {
+	return "FirewallAddressType"
+}
+
+func (t FirewallAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value := FirewallAddressValue{
+		StringValue: in,
+	}
+
+	return value, nil
+}
+
+func (t FirewallAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t FirewallAddressType) ValueType(ctx context.Context) attr.Value {
+	return FirewallAddressValue{}
+}
+
+// FirewallAddressValue
+
+var _ basetypes.StringValuable = FirewallAddressValue{}
+var _ basetypes.StringValuableWithSemanticEquals = FirewallAddressValue{}
+
+type FirewallAddressValue struct {
+	basetypes.StringValue
+}
+
+func (v FirewallAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(FirewallAddressValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v FirewallAddressValue) Type(ctx context.Context) attr.Type {
+	return FirewallAddressType{}
+}
+
+func (v FirewallAddressValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The framework should always pass the correct value type, but always check
+	newValue, ok := newValuable.(FirewallAddressValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// If the addresses are for the same range, keep the prior value
+	return canonicalFirewallAddress(v.ValueString()) == canonicalFirewallAddress(newValue.ValueString()), diags
+}
+
+// canonicalFirewallAddress returns the address in CIDR notation with any host bits cleared, e.g. "10.0.0.1/32" for
+// "10.0.0.1", or the address unchanged if it is not valid.
+func canonicalFirewallAddress(address string) string {
+	prefix, err := parsePrefixOrAddr(address)
+	if err != nil {
+		return address
+	}
+	return prefix.String()
+}
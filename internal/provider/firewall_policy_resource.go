@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-binarylane/internal/binarylane"
+	"terraform-provider-binarylane/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &firewallPolicyResource{}
+	_ resource.ResourceWithConfigure = &firewallPolicyResource{}
+)
+
+func NewFirewallPolicyResource() resource.Resource {
+	return &firewallPolicyResource{}
+}
+
+type firewallPolicyResource struct {
+	bc *BinarylaneClient
+}
+
+type firewallPolicyResourceModel struct {
+	Name               types.String                             `tfsdk:"name"`
+	FirewallRules      []binarylane.AdvancedFirewallRuleRequest `tfsdk:"firewall_rules"`
+	ServerIds          types.Set                                `tfsdk:"server_ids"`
+	MergeExistingRules types.Bool                               `tfsdk:"merge_existing_rules"`
+}
+
+func (r *firewallPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *firewallPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_policy"
+}
+
+func (r *firewallPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Each attribute of a rule has the same description as the binarylane_server_firewall_rules resource
+	rules := resources.ServerFirewallRulesResourceSchema(ctx).Attributes["firewall_rules"].(schema.ListNestedAttribute)
+	rule := rules.NestedObject.Attributes
+
+	resp.Schema = schema.Schema{
+		Description: "Provides a list of External Firewall Rules that is applied to each of a set of BinaryLane servers. " +
+			"Servers with firewall rules that no longer match the policy are reported as drift, and the policy is " +
+			"applied to them again.",
+		MarkdownDescription: "Provides a list of External Firewall Rules that is applied to each of a set of BinaryLane servers. " +
+			"Servers with firewall rules that no longer match the policy are reported as drift, and the policy is " +
+			"applied to them again.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "The name of the firewall policy.",
+				MarkdownDescription: "The name of the firewall policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"firewall_rules": schema.ListNestedAttribute{
+				Description: "The firewall rules of the policy, in the order that they are applied to each server. The " +
+					"first matching rule is applied, and if no rules match the traffic is permitted.",
+				MarkdownDescription: "The firewall rules of the policy, in the order that they are applied to each server. The " +
+					"first matching rule is applied, and if no rules match the traffic is permitted.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					FirewallRulesValidator{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description:         rule["action"].GetDescription(),
+							MarkdownDescription: rule["action"].GetMarkdownDescription(),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(binarylane.Drop), string(binarylane.Accept)),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description:         rule["description"].GetDescription(),
+							MarkdownDescription: rule["description"].GetMarkdownDescription(),
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 250),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Description:         rule["protocol"].GetDescription(),
+							MarkdownDescription: rule["protocol"].GetMarkdownDescription(),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(binarylane.All),
+									string(binarylane.Icmp),
+									string(binarylane.Tcp),
+									string(binarylane.Udp),
+								),
+							},
+						},
+						"source_addresses": schema.ListAttribute{
+							Description:         rule["source_addresses"].GetDescription(),
+							MarkdownDescription: rule["source_addresses"].GetMarkdownDescription(),
+							ElementType:         FirewallAddressType{}, // Ignore differences such as "10.0.0.1" and "10.0.0.1/32"
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+							},
+						},
+						"destination_addresses": schema.ListAttribute{
+							Description:         rule["destination_addresses"].GetDescription(),
+							MarkdownDescription: rule["destination_addresses"].GetMarkdownDescription(),
+							ElementType:         FirewallAddressType{}, // Ignore differences such as "10.0.0.1" and "10.0.0.1/32"
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+							},
+						},
+						"destination_ports": schema.ListAttribute{
+							Description:         rule["destination_ports"].GetDescription(),
+							MarkdownDescription: rule["destination_ports"].GetMarkdownDescription(),
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(FirewallPortValidator{}),
+								FirewallPortsProtocolValidator{},
+							},
+						},
+					},
+				},
+			},
+			"server_ids": schema.SetAttribute{
+				Description:         "The IDs of the servers to which the firewall policy is applied.",
+				MarkdownDescription: "The IDs of the servers to which the firewall policy is applied.",
+				ElementType:         types.Int64Type,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"merge_existing_rules": schema.BoolAttribute{
+				Description: "If true, any other firewall rules of each server are kept after the rules of the policy, " +
+					"e.g. server specific rules managed by binarylane_server_firewall_rule resources. Otherwise, the " +
+					"policy replaces all firewall rules of each server. Defaults to false.",
+				MarkdownDescription: "If `true`, any other firewall rules of each server are kept after the rules of the policy, " +
+					"e.g. server specific rules managed by `binarylane_server_firewall_rule` resources. Otherwise, the " +
+					"policy replaces all firewall rules of each server. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *firewallPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data firewallPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the policy cannot be applied to a server, save the servers it has already been applied to, so that the
+	// policy is removed from them when the tainted resource is destroyed
+	saveApplied := func(applied []int64) {
+		if len(applied) == 0 {
+			return
+		}
+		var diags diag.Diagnostics
+		data.ServerIds, diags = types.SetValueFrom(ctx, types.Int64Type, applied)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	// Create API call logic
+	for i, serverId := range serverIds {
+		found, err := r.bc.applyServerFirewallPolicy(ctx, serverId, nil, data.FirewallRules, data.MergeExistingRules.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error applying firewall policy: name=%s", data.Name.ValueString()), err.Error())
+			saveApplied(serverIds[:i])
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error applying firewall policy: name=%s", data.Name.ValueString()),
+				fmt.Sprintf("Could not find server: server_id=%d", serverId),
+			)
+			saveApplied(serverIds[:i])
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *firewallPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data firewallPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic, only keeping servers that still match the policy so that it is applied to the others again
+	var inSync []int64
+	for _, serverId := range serverIds {
+		rules, err := r.bc.getServerFirewallRules(ctx, serverId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error reading firewall policy: name=%s", data.Name.ValueString()), err.Error())
+			return
+		}
+		if rules == nil {
+			tflog.Warn(ctx, fmt.Sprintf("Server not found, removing from firewall policy: name=%s, server_id=%d",
+				data.Name.ValueString(), serverId))
+			continue
+		}
+
+		current := firewallRuleRequests(*rules)
+		if data.MergeExistingRules.ValueBool() && len(current) > len(data.FirewallRules) {
+			current = current[:len(data.FirewallRules)]
+		}
+		if !slices.EqualFunc(current, data.FirewallRules, firewallRuleRequestsEqual) {
+			resp.Diagnostics.AddWarning(
+				"Firewall policy drift detected",
+				fmt.Sprintf("The firewall rules of server %d do not match firewall policy %q, and the policy will be "+
+					"applied to the server again.", serverId, data.Name.ValueString()),
+			)
+			continue
+		}
+
+		inSync = append(inSync, serverId)
+	}
+
+	var diags diag.Diagnostics
+	data.ServerIds, diags = types.SetValueFrom(ctx, types.Int64Type, inSync)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *firewallPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state firewallPolicyResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, prior []int64
+	resp.Diagnostics.Append(plan.ServerIds.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.ServerIds.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesChanged := !slices.EqualFunc(state.FirewallRules, plan.FirewallRules, firewallRuleRequestsEqual) ||
+		!plan.MergeExistingRules.Equal(state.MergeExistingRules)
+
+	// Update API call logic
+	for _, serverId := range planned {
+		if !rulesChanged && slices.Contains(prior, serverId) {
+			continue
+		}
+		found, err := r.bc.applyServerFirewallPolicy(ctx, serverId, state.FirewallRules, plan.FirewallRules, plan.MergeExistingRules.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error applying firewall policy: name=%s", plan.Name.ValueString()), err.Error())
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error applying firewall policy: name=%s", plan.Name.ValueString()),
+				fmt.Sprintf("Could not find server: server_id=%d", serverId),
+			)
+			return
+		}
+	}
+
+	// Remove the policy from servers that are no longer included
+	for _, serverId := range prior {
+		if slices.Contains(planned, serverId) {
+			continue
+		}
+		_, err := r.bc.applyServerFirewallPolicy(ctx, serverId, state.FirewallRules, nil, state.MergeExistingRules.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error removing firewall policy: name=%s", plan.Name.ValueString()), err.Error())
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *firewallPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data firewallPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	for _, serverId := range serverIds {
+		_, err := r.bc.applyServerFirewallPolicy(ctx, serverId, data.FirewallRules, nil, data.MergeExistingRules.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error removing firewall policy: name=%s", data.Name.ValueString()), err.Error())
+			return
+		}
+	}
+}
+
+// applyServerFirewallPolicy changes the firewall rules of the server from the prior rules of a policy to the planned
+// rules, returning false if the server does not exist. If merge is true, any other rules of the server are kept after
+// the rules of the policy, otherwise they are removed.
+func (bc *BinarylaneClient) applyServerFirewallPolicy(
+	ctx context.Context, serverId int64, prior []binarylane.AdvancedFirewallRuleRequest,
+	planned []binarylane.AdvancedFirewallRuleRequest, merge bool,
+) (bool, error) {
+	unlock := bc.serverLocks.lock(serverId)
+	defer unlock()
+
+	rules, err := bc.getServerFirewallRules(ctx, serverId)
+	if err != nil {
+		return false, err
+	}
+	if rules == nil {
+		return false, nil
+	}
+	current := firewallRuleRequests(*rules)
+
+	requests := slices.Clone(planned)
+	if merge {
+		for _, rule := range current {
+			isPolicyRule := func(r binarylane.AdvancedFirewallRuleRequest) bool { return firewallRuleRequestsEqual(r, rule) }
+			if !slices.ContainsFunc(prior, isPolicyRule) && !slices.ContainsFunc(planned, isPolicyRule) {
+				requests = append(requests, rule)
+			}
+		}
+	}
+	if requests == nil {
+		requests = []binarylane.AdvancedFirewallRuleRequest{}
+	}
+
+	if slices.EqualFunc(current, requests, firewallRuleRequestsEqual) {
+		tflog.Debug(ctx, fmt.Sprintf("Server firewall rules already match firewall policy: server_id=%d", serverId))
+		return true, nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Applying firewall policy to server: server_id=%d", serverId))
+	return true, bc.changeServerFirewallRules(ctx, serverId, requests)
+}
+
+// firewallRuleRequestsEqual returns true if both rules match the same traffic with the same action and description.
+func firewallRuleRequestsEqual(a, b binarylane.AdvancedFirewallRuleRequest) bool {
+	var aPorts, bPorts []string
+	if a.DestinationPorts != nil {
+		aPorts = *a.DestinationPorts
+	}
+	if b.DestinationPorts != nil {
+		bPorts = *b.DestinationPorts
+	}
+
+	var aDescription, bDescription string
+	if a.Description != nil {
+		aDescription = *a.Description
+	}
+	if b.Description != nil {
+		bDescription = *b.Description
+	}
+
+	addressesEqual := func(a, b string) bool {
+		return canonicalFirewallAddress(a) == canonicalFirewallAddress(b)
+	}
+
+	return a.Action == b.Action &&
+		a.Protocol == b.Protocol &&
+		aDescription == bDescription &&
+		slices.EqualFunc(a.SourceAddresses, b.SourceAddresses, addressesEqual) &&
+		slices.EqualFunc(a.DestinationAddresses, b.DestinationAddresses, addressesEqual) &&
+		slices.Equal(aPorts, bPorts)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFirewallPolicyResource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	servers := `
+resource "binarylane_server" "test" {
+  count             = 2
+  name              = "tf-test-fw-policy-${count.index}"
+  region            = "per"
+  image             = "debian-12"
+  size              = "std-min"
+  password          = "` + password + `"
+  public_ipv4_count = 0
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + servers + `
+resource "binarylane_firewall_policy" "test" {
+  name       = "tf-test-fw-policy"
+  server_ids = binarylane_server.test[*].id
+  firewall_rules = [
+    {
+      description           = "Allow SSH"
+      protocol              = "tcp"
+      source_addresses      = ["10.0.0.0/8"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+    {
+      description           = "Block SSH"
+      protocol              = "tcp"
+      source_addresses      = ["0.0.0.0/0"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "drop"
+    },
+  ]
+}
+
+data "binarylane_server_firewall_rules" "test" {
+  count     = 2
+  server_id = binarylane_server.test[count.index].id
+
+  depends_on = [binarylane_firewall_policy.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_firewall_policy.test", "server_ids.#", "2"),
+					resource.TestCheckResourceAttr("binarylane_firewall_policy.test", "merge_existing_rules", "false"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.0", "firewall_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.0", "firewall_rules.0.description", "Allow SSH"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.1", "firewall_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.1", "firewall_rules.1.action", "drop"),
+				),
+			},
+			// Merge with a server specific rule, and remove the policy from the second server
+			{
+				Config: providerConfig + servers + `
+resource "binarylane_firewall_policy" "test" {
+  name                 = "tf-test-fw-policy"
+  server_ids           = [binarylane_server.test.0.id]
+  merge_existing_rules = true
+  firewall_rules = [
+    {
+      description           = "Allow SSH"
+      protocol              = "tcp"
+      source_addresses      = ["10.0.0.0/8"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+  ]
+}
+
+resource "binarylane_server_firewall_rule" "test" {
+  server_id             = binarylane_server.test.0.id
+  description           = "Allow HTTP"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = ["0.0.0.0/0"]
+  destination_ports     = ["80"]
+  action                = "accept"
+
+  depends_on = [binarylane_firewall_policy.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_firewall_policy.test", "server_ids.#", "1"),
+					resource.TestCheckResourceAttr("binarylane_firewall_policy.test", "merge_existing_rules", "true"),
+				),
+			},
+			// Verify the firewall rules of each server
+			{
+				Config: providerConfig + servers + `
+resource "binarylane_firewall_policy" "test" {
+  name                 = "tf-test-fw-policy"
+  server_ids           = [binarylane_server.test.0.id]
+  merge_existing_rules = true
+  firewall_rules = [
+    {
+      description           = "Allow SSH"
+      protocol              = "tcp"
+      source_addresses      = ["10.0.0.0/8"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+  ]
+}
+
+resource "binarylane_server_firewall_rule" "test" {
+  server_id             = binarylane_server.test.0.id
+  description           = "Allow HTTP"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = ["0.0.0.0/0"]
+  destination_ports     = ["80"]
+  action                = "accept"
+
+  depends_on = [binarylane_firewall_policy.test]
+}
+
+data "binarylane_server_firewall_rules" "test" {
+  count     = 2
+  server_id = binarylane_server.test[count.index].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.0", "firewall_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.0", "firewall_rules.0.description", "Allow SSH"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.0", "firewall_rules.1.description", "Allow HTTP"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test.1", "firewall_rules.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ validator.String = FirewallAddressValidator{}
+	_ validator.String = FirewallPortValidator{}
+	_ validator.List   = FirewallPortsProtocolValidator{}
+	_ validator.List   = FirewallRulesValidator{}
+)
+
+// FirewallAddressValidator validates that a string is an IPv4 address or a range in IPv4 CIDR notation.
+type FirewallAddressValidator struct{}
+
+func (v FirewallAddressValidator) Description(ctx context.Context) string {
+	return "must be an IPv4 address or a range in IPv4 CIDR notation"
+}
+
+func (v FirewallAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v FirewallAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	address := req.ConfigValue.ValueString()
+	prefix, err := parsePrefixOrAddr(address)
+	if err == nil && !prefix.Addr().Is4() {
+		err = fmt.Errorf("not an IPv4 address")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Firewall Address",
+			fmt.Sprintf("%q %s: %s", address, v.Description(ctx), err),
+		)
+	}
+}
+
+// FirewallPortValidator validates that a string is a port, e.g. "80", or an inclusive range of ports, e.g. "8000-8080".
+type FirewallPortValidator struct{}
+
+func (v FirewallPortValidator) Description(ctx context.Context) string {
+	return "must be a port between 1 and 65535, or a range of ports such as 8000-8080"
+}
+
+func (v FirewallPortValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be a port between 1 and 65535, or a range of ports such as `8000-8080`"
+}
+
+func (v FirewallPortValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	port := req.ConfigValue.ValueString()
+	if _, _, err := parseFirewallPortRange(port); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Firewall Port",
+			fmt.Sprintf("%q %s: %s", port, v.Description(ctx), err),
+		)
+	}
+}
+
+// FirewallPortsProtocolValidator validates that destination ports are not specified for a rule that matches ICMP
+// traffic, which does not have ports.
+type FirewallPortsProtocolValidator struct{}
+
+func (v FirewallPortsProtocolValidator) Description(ctx context.Context) string {
+	return "must not be specified when protocol is icmp"
+}
+
+func (v FirewallPortsProtocolValidator) MarkdownDescription(ctx context.Context) string {
+	return "must not be specified when `protocol` is `icmp`"
+}
+
+func (v FirewallPortsProtocolValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsUnknown() || len(req.ConfigValue.Elements()) == 0 {
+		return
+	}
+
+	var protocol types.String
+	protocolPath := req.Path.ParentPath().AtName("protocol")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, protocolPath, &protocol)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if protocol.ValueString() == string(binarylane.Icmp) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Firewall Ports",
+			"Destination ports cannot be specified for a rule that matches ICMP traffic, because ICMP does not have ports. "+
+				"Remove destination_ports, or change the protocol of the rule.",
+		)
+	}
+}
+
+// FirewallRulesValidator adds a warning for each rule in a list of firewall rules that can never be applied, because
+// an earlier rule matches all of the same traffic.
+type FirewallRulesValidator struct{}
+
+func (v FirewallRulesValidator) Description(ctx context.Context) string {
+	return "should not contain duplicate or shadowed rules"
+}
+
+func (v FirewallRulesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v FirewallRulesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var rules []firewallRuleConfig
+	if diags := req.ConfigValue.ElementsAs(ctx, &rules, false); diags.HasError() {
+		return
+	}
+
+	// Rules that are not known yet, or that are not valid, cannot be compared
+	matches := make([]*firewallRuleMatch, len(rules))
+	for i := range rules {
+		matches[i] = rules[i].match(ctx)
+	}
+
+	for j := range matches {
+		if matches[j] == nil {
+			continue
+		}
+		for i := range j {
+			if matches[i] == nil || !matches[i].covers(matches[j]) {
+				continue
+			}
+			if matches[j].covers(matches[i]) {
+				resp.Diagnostics.AddAttributeWarning(
+					req.Path.AtListIndex(j),
+					"Duplicate Firewall Rule",
+					fmt.Sprintf("Firewall rule %d matches the same traffic as firewall rule %d, so it will never be applied.", j, i),
+				)
+			} else {
+				resp.Diagnostics.AddAttributeWarning(
+					req.Path.AtListIndex(j),
+					"Shadowed Firewall Rule",
+					fmt.Sprintf("Firewall rule %d will never be applied, because all of the traffic it matches is "+
+						"matched by the earlier firewall rule %d.", j, i),
+				)
+			}
+			break
+		}
+	}
+}
+
+type firewallRuleConfig struct {
+	Action               types.String `tfsdk:"action"`
+	Description          types.String `tfsdk:"description"`
+	Protocol             types.String `tfsdk:"protocol"`
+	SourceAddresses      types.List   `tfsdk:"source_addresses"`
+	DestinationAddresses types.List   `tfsdk:"destination_addresses"`
+	DestinationPorts     types.List   `tfsdk:"destination_ports"`
+}
+
+// firewallRuleMatch is the traffic matched by a firewall rule, where no ports matches all ports.
+type firewallRuleMatch struct {
+	protocol             string
+	sourceAddresses      []netip.Prefix
+	destinationAddresses []netip.Prefix
+	destinationPorts     [][2]int
+}
+
+// match returns the traffic matched by the rule, or nil if the rule is unknown or invalid.
+func (rule *firewallRuleConfig) match(ctx context.Context) *firewallRuleMatch {
+	isUnknown := func(list types.List) bool {
+		return list.IsUnknown() || slices.ContainsFunc(list.Elements(), attr.Value.IsUnknown)
+	}
+	if rule.Protocol.IsUnknown() || isUnknown(rule.SourceAddresses) || isUnknown(rule.DestinationAddresses) ||
+		isUnknown(rule.DestinationPorts) {
+		return nil
+	}
+
+	var sourceAddresses, destinationAddresses, destinationPorts []string
+	if rule.SourceAddresses.ElementsAs(ctx, &sourceAddresses, false).HasError() ||
+		rule.DestinationAddresses.ElementsAs(ctx, &destinationAddresses, false).HasError() ||
+		rule.DestinationPorts.ElementsAs(ctx, &destinationPorts, false).HasError() {
+		return nil
+	}
+
+	match := firewallRuleMatch{protocol: rule.Protocol.ValueString()}
+	for _, address := range sourceAddresses {
+		prefix, err := parsePrefixOrAddr(address)
+		if err != nil {
+			return nil
+		}
+		match.sourceAddresses = append(match.sourceAddresses, prefix)
+	}
+	for _, address := range destinationAddresses {
+		prefix, err := parsePrefixOrAddr(address)
+		if err != nil {
+			return nil
+		}
+		match.destinationAddresses = append(match.destinationAddresses, prefix)
+	}
+	for _, port := range destinationPorts {
+		from, to, err := parseFirewallPortRange(port)
+		if err != nil {
+			return nil
+		}
+		match.destinationPorts = append(match.destinationPorts, [2]int{from, to})
+	}
+
+	return &match
+}
+
+// covers returns true if all of the traffic matched by other is also matched by m.
+func (m *firewallRuleMatch) covers(other *firewallRuleMatch) bool {
+	if m.protocol != string(binarylane.All) && m.protocol != other.protocol {
+		return false
+	}
+
+	prefixesCover := func(prefixes []netip.Prefix, others []netip.Prefix) bool {
+		return !slices.ContainsFunc(others, func(o netip.Prefix) bool {
+			return !slices.ContainsFunc(prefixes, func(p netip.Prefix) bool {
+				return p.Bits() <= o.Bits() && p.Contains(o.Addr())
+			})
+		})
+	}
+	if !prefixesCover(m.sourceAddresses, other.sourceAddresses) ||
+		!prefixesCover(m.destinationAddresses, other.destinationAddresses) {
+		return false
+	}
+
+	if len(m.destinationPorts) == 0 {
+		return true
+	}
+	if len(other.destinationPorts) == 0 {
+		return false
+	}
+	return !slices.ContainsFunc(other.destinationPorts, func(o [2]int) bool {
+		return !slices.ContainsFunc(m.destinationPorts, func(p [2]int) bool {
+			return p[0] <= o[0] && o[1] <= p[1]
+		})
+	})
+}
+
+// matchesTraffic returns true if traffic of the protocol from the source address is matched by m. A nil destination
+// matches any destination address, and a nil port is only matched when m matches all ports.
+func (m *firewallRuleMatch) matchesTraffic(protocol string, source netip.Addr, destination *netip.Addr, port *int) bool {
+	if m.protocol != string(binarylane.All) && m.protocol != protocol {
+		return false
+	}
+
+	containsAddr := func(prefixes []netip.Prefix, addr netip.Addr) bool {
+		return slices.ContainsFunc(prefixes, func(p netip.Prefix) bool {
+			return p.Contains(addr)
+		})
+	}
+	if !containsAddr(m.sourceAddresses, source) {
+		return false
+	}
+	if destination != nil && !containsAddr(m.destinationAddresses, *destination) {
+		return false
+	}
+
+	if len(m.destinationPorts) == 0 {
+		return true
+	}
+	if port == nil {
+		return false
+	}
+	return slices.ContainsFunc(m.destinationPorts, func(p [2]int) bool {
+		return p[0] <= *port && *port <= p[1]
+	})
+}
+
+// parseFirewallPortRange parses a port, e.g. "80", or an inclusive range of ports, e.g. "8000-8080".
+func parseFirewallPortRange(s string) (int, int, error) {
+	fromStr, toStr, isRange := strings.Cut(s, "-")
+	from, err := parseFirewallPort(fromStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return from, from, nil
+	}
+	to, err := parseFirewallPort(toStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from > to {
+		return 0, 0, fmt.Errorf("start of range %d is greater than the end of range %d", from, to)
+	}
+	return from, to, nil
+}
+
+func parseFirewallPort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%d is not between 1 and 65535", port)
+	}
+	return port, nil
+}
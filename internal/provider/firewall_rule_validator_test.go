@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testFirewallRule returns the configuration of a firewall rule, where nil ports are not configured.
+func testFirewallRule(t *testing.T, protocol string, sourceAddresses, destinationAddresses, destinationPorts []string) firewallRuleConfig {
+	t.Helper()
+	ctx := context.Background()
+
+	list := func(elements []string) types.List {
+		if elements == nil {
+			return types.ListNull(types.StringType)
+		}
+		value, diags := types.ListValueFrom(ctx, types.StringType, elements)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return value
+	}
+
+	return firewallRuleConfig{
+		Action:               types.StringValue("accept"),
+		Description:          types.StringNull(),
+		Protocol:             types.StringValue(protocol),
+		SourceAddresses:      list(sourceAddresses),
+		DestinationAddresses: list(destinationAddresses),
+		DestinationPorts:     list(destinationPorts),
+	}
+}
+
+func TestFirewallRuleMatchCovers(t *testing.T) {
+	anywhere := []string{"0.0.0.0/0"}
+
+	testCases := []struct {
+		name     string
+		rule     firewallRuleConfig
+		other    firewallRuleConfig
+		expected bool
+	}{
+		{
+			name:     "all protocols covers tcp",
+			rule:     testFirewallRule(t, "all", anywhere, anywhere, nil),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, nil),
+			expected: true,
+		},
+		{
+			name:     "tcp does not cover all protocols",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, nil),
+			other:    testFirewallRule(t, "all", anywhere, anywhere, nil),
+			expected: false,
+		},
+		{
+			name:     "tcp does not cover udp",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, nil),
+			other:    testFirewallRule(t, "udp", anywhere, anywhere, nil),
+			expected: false,
+		},
+		{
+			name:     "larger prefix covers contained prefix",
+			rule:     testFirewallRule(t, "tcp", []string{"10.0.0.0/8"}, anywhere, nil),
+			other:    testFirewallRule(t, "tcp", []string{"10.1.0.0/16"}, anywhere, nil),
+			expected: true,
+		},
+		{
+			name:     "contained prefix does not cover larger prefix",
+			rule:     testFirewallRule(t, "tcp", anywhere, []string{"10.1.0.0/16"}, nil),
+			other:    testFirewallRule(t, "tcp", anywhere, []string{"10.0.0.0/8"}, nil),
+			expected: false,
+		},
+		{
+			name:     "every address must be covered by some prefix",
+			rule:     testFirewallRule(t, "tcp", []string{"10.0.0.0/8", "192.168.0.0/16"}, anywhere, nil),
+			other:    testFirewallRule(t, "tcp", []string{"192.168.1.0/24", "10.0.0.1"}, anywhere, nil),
+			expected: true,
+		},
+		{
+			name:     "address outside of prefixes is not covered",
+			rule:     testFirewallRule(t, "tcp", []string{"10.0.0.0/8"}, anywhere, nil),
+			other:    testFirewallRule(t, "tcp", []string{"10.0.0.1", "172.16.0.1"}, anywhere, nil),
+			expected: false,
+		},
+		{
+			name:     "port range covers contained ports",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, []string{"8000-8080"}),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, []string{"8010-8020", "8080"}),
+			expected: true,
+		},
+		{
+			name:     "port range does not cover overlapping range",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, []string{"8000-8080"}),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, []string{"8079-8081"}),
+			expected: false,
+		},
+		{
+			name:     "no ports covers any ports",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, nil),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, []string{"22"}),
+			expected: true,
+		},
+		{
+			name:     "empty ports covers any ports",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, []string{}),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, []string{"22"}),
+			expected: true,
+		},
+		{
+			name:     "ports do not cover no ports",
+			rule:     testFirewallRule(t, "tcp", anywhere, anywhere, []string{"1-65535"}),
+			other:    testFirewallRule(t, "tcp", anywhere, anywhere, nil),
+			expected: false,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, other := tc.rule.match(ctx), tc.other.match(ctx)
+			if rule == nil || other == nil {
+				t.Fatalf("expected both rules to be valid")
+			}
+			if actual := rule.covers(other); actual != tc.expected {
+				t.Errorf("expected covers to be %t, got: %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFirewallRulesValidator(t *testing.T) {
+	anywhere := []string{"0.0.0.0/0"}
+
+	testCases := []struct {
+		name     string
+		rules    []firewallRuleConfig
+		expected map[int]string // summary of the warning expected for each rule
+	}{
+		{
+			name: "no overlap",
+			rules: []firewallRuleConfig{
+				testFirewallRule(t, "tcp", anywhere, anywhere, []string{"22"}),
+				testFirewallRule(t, "tcp", anywhere, anywhere, []string{"80"}),
+			},
+			expected: map[int]string{},
+		},
+		{
+			name: "narrower rule before broader rule",
+			rules: []firewallRuleConfig{
+				testFirewallRule(t, "tcp", []string{"10.0.0.0/8"}, anywhere, []string{"22"}),
+				testFirewallRule(t, "all", anywhere, anywhere, nil),
+			},
+			expected: map[int]string{},
+		},
+		{
+			name: "duplicate with equivalent addresses",
+			rules: []firewallRuleConfig{
+				testFirewallRule(t, "tcp", []string{"10.0.0.1"}, anywhere, []string{"22"}),
+				testFirewallRule(t, "tcp", []string{"10.0.0.1/32"}, anywhere, []string{"22"}),
+			},
+			expected: map[int]string{1: "Duplicate Firewall Rule"},
+		},
+		{
+			name: "shadowed by broader rule",
+			rules: []firewallRuleConfig{
+				testFirewallRule(t, "all", anywhere, anywhere, nil),
+				testFirewallRule(t, "udp", anywhere, anywhere, []string{"53"}),
+				testFirewallRule(t, "tcp", []string{"10.0.0.0/8"}, anywhere, []string{"22"}),
+			},
+			expected: map[int]string{1: "Shadowed Firewall Rule", 2: "Shadowed Firewall Rule"},
+		},
+	}
+
+	ctx := context.Background()
+	elementType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"action":                types.StringType,
+		"description":           types.StringType,
+		"protocol":              types.StringType,
+		"source_addresses":      types.ListType{ElemType: types.StringType},
+		"destination_addresses": types.ListType{ElemType: types.StringType},
+		"destination_ports":     types.ListType{ElemType: types.StringType},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, diags := types.ListValueFrom(ctx, elementType, tc.rules)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			req := validator.ListRequest{
+				Path:        path.Root("firewall_rules"),
+				ConfigValue: rules,
+			}
+			resp := &validator.ListResponse{}
+			FirewallRulesValidator{}.ValidateList(ctx, req, resp)
+
+			if resp.Diagnostics.ErrorsCount() > 0 {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != len(tc.expected) {
+				t.Fatalf("expected %d warnings, got: %v", len(tc.expected), warnings)
+			}
+			for i, summary := range tc.expected {
+				expectedPath := path.Root("firewall_rules").AtListIndex(i)
+				found := false
+				for _, warning := range warnings {
+					if warning.Summary() == summary && warning.(diag.DiagnosticWithPath).Path().Equal(expectedPath) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected warning %q for firewall rule %d, got: %v", summary, i, warnings)
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalFirewallAddress(t *testing.T) {
+	testCases := map[string]string{
+		"10.0.0.1":    "10.0.0.1/32",
+		"10.0.0.1/32": "10.0.0.1/32",
+		"10.1.2.3/8":  "10.0.0.0/8",
+		"0.0.0.0/0":   "0.0.0.0/0",
+		"not-an-ip":   "not-an-ip",
+	}
+
+	for address, expected := range testCases {
+		if actual := canonicalFirewallAddress(address); actual != expected {
+			t.Errorf("canonicalFirewallAddress(%q): expected %q, got: %q", address, expected, actual)
+		}
+	}
+
+	ctx := context.Background()
+	equal, diags := FirewallAddressValue{types.StringValue("10.0.0.1")}.StringSemanticEquals(
+		ctx, FirewallAddressValue{types.StringValue("10.0.0.1/32")})
+	if diags.HasError() || !equal {
+		t.Errorf("expected 10.0.0.1 and 10.0.0.1/32 to be semantically equal")
+	}
+	equal, diags = FirewallAddressValue{types.StringValue("10.0.0.1")}.StringSemanticEquals(
+		ctx, FirewallAddressValue{types.StringValue("10.0.0.2")})
+	if diags.HasError() || equal {
+		t.Errorf("expected 10.0.0.1 and 10.0.0.2 not to be semantically equal")
+	}
+}
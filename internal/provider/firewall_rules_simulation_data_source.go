@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"terraform-provider-binarylane/internal/binarylane"
+	"terraform-provider-binarylane/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	r_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &firewallRulesSimulationDataSource{}
+)
+
+func NewFirewallRulesSimulationDataSource() datasource.DataSource {
+	return &firewallRulesSimulationDataSource{}
+}
+
+type firewallRulesSimulationDataSource struct{}
+
+type firewallRulesSimulationDataSourceModel struct {
+	FirewallRules []firewallRuleConfig                 `tfsdk:"firewall_rules"`
+	Probes        []firewallRulesSimulationProbeModel  `tfsdk:"probes"`
+	Results       []firewallRulesSimulationResultModel `tfsdk:"results"`
+}
+
+type firewallRulesSimulationProbeModel struct {
+	Protocol           types.String `tfsdk:"protocol"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.Int64  `tfsdk:"destination_port"`
+}
+
+type firewallRulesSimulationResultModel struct {
+	Action          types.String `tfsdk:"action"`
+	RuleIndex       types.Int64  `tfsdk:"rule_index"`
+	RuleDescription types.String `tfsdk:"rule_description"`
+}
+
+func (d *firewallRulesSimulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rules_simulation"
+}
+
+func (d *firewallRulesSimulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Each attribute of the rule has the same description as the binarylane_server_firewall_rules resource
+	rules := resources.ServerFirewallRulesResourceSchema(ctx).Attributes["firewall_rules"].(r_schema.ListNestedAttribute)
+	rule := rules.NestedObject.Attributes
+
+	resp.Schema = schema.Schema{
+		Description: "Simulate an ordered list of External Firewall Rules against network traffic, to determine whether " +
+			"the traffic would be accepted or dropped. The first rule that matches the traffic is applied, and if no " +
+			"rules match the traffic is accepted. The simulation is performed by the provider, without any API calls, " +
+			"so it may be used in check blocks to verify firewall rules before they are applied.",
+		MarkdownDescription: "Simulate an ordered list of External Firewall Rules against network traffic, to determine " +
+			"whether the traffic would be accepted or dropped. The first rule that matches the traffic is applied, and " +
+			"if no rules match the traffic is accepted. The simulation is performed by the provider, without any API " +
+			"calls, so it may be used in `check` blocks to verify firewall rules before they are applied.",
+		Attributes: map[string]schema.Attribute{
+			"firewall_rules": schema.ListNestedAttribute{
+				Description: "The ordered list of firewall rules to simulate, such as the firewall_rules of a " +
+					"binarylane_server_firewall_rules resource.",
+				MarkdownDescription: "The ordered list of firewall rules to simulate, such as the `firewall_rules` of a " +
+					"`binarylane_server_firewall_rules` resource.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description:         rule["description"].GetDescription(),
+							MarkdownDescription: rule["description"].GetMarkdownDescription(),
+							Optional:            true,
+						},
+						"action": schema.StringAttribute{
+							Description:         rule["action"].GetDescription(),
+							MarkdownDescription: rule["action"].GetMarkdownDescription(),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(binarylane.Drop), string(binarylane.Accept)),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Description:         rule["protocol"].GetDescription(),
+							MarkdownDescription: rule["protocol"].GetMarkdownDescription(),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(binarylane.All),
+									string(binarylane.Icmp),
+									string(binarylane.Tcp),
+									string(binarylane.Udp),
+								),
+							},
+						},
+						"source_addresses": schema.ListAttribute{
+							Description:         rule["source_addresses"].GetDescription(),
+							MarkdownDescription: rule["source_addresses"].GetMarkdownDescription(),
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+							},
+						},
+						"destination_addresses": schema.ListAttribute{
+							Description:         rule["destination_addresses"].GetDescription(),
+							MarkdownDescription: rule["destination_addresses"].GetMarkdownDescription(),
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+							},
+						},
+						"destination_ports": schema.ListAttribute{
+							Description:         rule["destination_ports"].GetDescription(),
+							MarkdownDescription: rule["destination_ports"].GetMarkdownDescription(),
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(FirewallPortValidator{}),
+								FirewallPortsProtocolValidator{},
+							},
+						},
+					},
+				},
+			},
+			"probes": schema.ListNestedAttribute{
+				Description:         "The network traffic to simulate against the firewall rules.",
+				MarkdownDescription: "The network traffic to simulate against the firewall rules.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{
+							Description:         "The protocol of the traffic.",
+							MarkdownDescription: "The protocol of the traffic.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(binarylane.Icmp),
+									string(binarylane.Tcp),
+									string(binarylane.Udp),
+								),
+							},
+						},
+						"source_address": schema.StringAttribute{
+							Description:         "The IPv4 address the traffic is sent from.",
+							MarkdownDescription: "The IPv4 address the traffic is sent from.",
+							Required:            true,
+						},
+						"destination_address": schema.StringAttribute{
+							Description: "The IPv4 address the traffic is sent to. If null, the destination addresses " +
+								"of the firewall rules are ignored.",
+							MarkdownDescription: "The IPv4 address the traffic is sent to. If null, the destination " +
+								"addresses of the firewall rules are ignored.",
+							Optional: true,
+						},
+						"destination_port": schema.Int64Attribute{
+							Description: "The port the traffic is sent to. If null, the traffic is only matched by " +
+								"firewall rules that match all ports.",
+							MarkdownDescription: "The port the traffic is sent to. If null, the traffic is only matched by " +
+								"firewall rules that match all ports.",
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description:         "The result of simulating each of the probes, in the same order as probes.",
+				MarkdownDescription: "The result of simulating each of the probes, in the same order as `probes`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description:         "Whether the traffic would be accepted or dropped.",
+							MarkdownDescription: "Whether the traffic would be `accept`ed or `drop`ped.",
+							Computed:            true,
+						},
+						"rule_index": schema.Int64Attribute{
+							Description: "The index of the first firewall rule that matches the traffic, or null if " +
+								"no rules match.",
+							MarkdownDescription: "The index of the first firewall rule that matches the traffic, or null " +
+								"if no rules match.",
+							Computed: true,
+						},
+						"rule_description": schema.StringAttribute{
+							Description: "The description of the first firewall rule that matches the traffic, or null " +
+								"if no rules match.",
+							MarkdownDescription: "The description of the first firewall rule that matches the traffic, or " +
+								"null if no rules match.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *firewallRulesSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data firewallRulesSimulationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := make([]*firewallRuleMatch, len(data.FirewallRules))
+	for i := range data.FirewallRules {
+		matches[i] = data.FirewallRules[i].match(ctx)
+		if matches[i] == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("firewall_rules").AtListIndex(i),
+				"Invalid Firewall Rule",
+				fmt.Sprintf("Firewall rule %d could not be simulated, because it contains an invalid address or port.", i),
+			)
+		}
+	}
+
+	data.Results = make([]firewallRulesSimulationResultModel, len(data.Probes))
+	for i, probe := range data.Probes {
+		probePath := path.Root("probes").AtListIndex(i)
+
+		source, err := netip.ParseAddr(probe.SourceAddress.ValueString())
+		if err != nil || !source.Is4() {
+			resp.Diagnostics.AddAttributeError(probePath.AtName("source_address"), "Invalid Probe Address",
+				fmt.Sprintf("%q must be an IPv4 address.", probe.SourceAddress.ValueString()))
+			continue
+		}
+		var destination *netip.Addr
+		if !probe.DestinationAddress.IsNull() {
+			addr, err := netip.ParseAddr(probe.DestinationAddress.ValueString())
+			if err != nil || !addr.Is4() {
+				resp.Diagnostics.AddAttributeError(probePath.AtName("destination_address"), "Invalid Probe Address",
+					fmt.Sprintf("%q must be an IPv4 address.", probe.DestinationAddress.ValueString()))
+				continue
+			}
+			destination = &addr
+		}
+		var port *int
+		if !probe.DestinationPort.IsNull() {
+			p := int(probe.DestinationPort.ValueInt64())
+			port = &p
+		}
+
+		// If no rules match the traffic is permitted
+		data.Results[i] = firewallRulesSimulationResultModel{
+			Action:          types.StringValue(string(binarylane.Accept)),
+			RuleIndex:       types.Int64Null(),
+			RuleDescription: types.StringNull(),
+		}
+		index := slices.IndexFunc(matches, func(m *firewallRuleMatch) bool {
+			return m != nil && m.matchesTraffic(probe.Protocol.ValueString(), source, destination, port)
+		})
+		if index >= 0 {
+			data.Results[i] = firewallRulesSimulationResultModel{
+				Action:          data.FirewallRules[index].Action,
+				RuleIndex:       types.Int64Value(int64(index)),
+				RuleDescription: data.FirewallRules[index].Description,
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFirewallRulesSimulationDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "binarylane_firewall_rules_simulation" "test" {
+  firewall_rules = [
+    {
+      description           = "Allow SSH from LAN"
+      protocol              = "tcp"
+      source_addresses      = ["10.0.0.0/8"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["22"]
+      action                = "accept"
+    },
+    {
+      description           = "Block SSH"
+      protocol              = "tcp"
+      source_addresses      = ["0.0.0.0/0"]
+      destination_addresses = ["0.0.0.0/0"]
+      destination_ports     = ["20-25"]
+      action                = "drop"
+    },
+    {
+      protocol              = "icmp"
+      source_addresses      = ["0.0.0.0/0"]
+      destination_addresses = ["192.168.0.1"]
+      action                = "drop"
+    },
+  ]
+  probes = [
+    {
+      protocol         = "tcp"
+      source_address   = "10.1.2.3"
+      destination_port = 22
+    },
+    {
+      protocol         = "tcp"
+      source_address   = "198.51.100.1"
+      destination_port = 22
+    },
+    {
+      protocol         = "tcp"
+      source_address   = "198.51.100.1"
+      destination_port = 80
+    },
+    {
+      protocol            = "icmp"
+      source_address      = "198.51.100.1"
+      destination_address = "192.168.0.1"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.#", "4"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.0.action", "accept"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.0.rule_index", "0"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.0.rule_description", "Allow SSH from LAN"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.1.action", "drop"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.1.rule_index", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.2.action", "accept"),
+					resource.TestCheckNoResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.2.rule_index"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.3.action", "drop"),
+					resource.TestCheckResourceAttr("data.binarylane_firewall_rules_simulation.test", "results.3.rule_index", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "binarylane_firewall_rules_simulation" "test" {
+  firewall_rules = []
+  probes = [
+    {
+      protocol       = "tcp"
+      source_address = "10.0.0.0/8"
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Probe Address"),
+			},
+		},
+	})
+}
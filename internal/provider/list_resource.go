@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newListResult returns the result of listing a resource that is identified by its numeric ID. If the request includes
+// the resource, the model is initialised from the resource schema and passed to setResource to populate its attributes.
+func newListResult[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	id int64,
+	displayName string,
+	setResource func(data *T) diag.Diagnostics,
+) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{Id: types.Int64Value(id)})...)
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Setting the ID initialises all other attributes of the resource to null
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+
+	var data T
+	result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(setResource(&data)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	return result
+}
+
+// listResults streams a list result for each item, stopping early if the limit of the request is reached.
+func listResults[T any](req list.ListRequest, items []T, newResult func(item T) list.ListResult) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(newResult(item)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerAttachmentResource{}
+	_ resource.ResourceWithImportState = &loadBalancerAttachmentResource{}
+)
+
+func NewLoadBalancerAttachmentResource() resource.Resource {
+	return &loadBalancerAttachmentResource{}
+}
+
+type loadBalancerAttachmentResource struct {
+	bc *BinarylaneClient
+}
+
+type loadBalancerAttachmentResourceModel struct {
+	LoadBalancerId types.Int64 `tfsdk:"load_balancer_id"`
+	ServerIds      types.Set   `tfsdk:"server_ids"`
+}
+
+func (r *loadBalancerAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *loadBalancerAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_attachment"
+}
+
+func (r *loadBalancerAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns servers to a BinaryLane load balancer, without affecting any other servers assigned to the " +
+			"load balancer. Multiple attachments can be defined for the same load balancer, and the load balancer " +
+			"should set ignore_server_ids to true so that it does not remove the attached servers.",
+		MarkdownDescription: "Assigns servers to a BinaryLane load balancer, without affecting any other servers assigned to the " +
+			"load balancer. Multiple attachments can be defined for the same load balancer, and the `binarylane_load_balancer` " +
+			"should set `ignore_server_ids = true` so that it does not remove the attached servers.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.Int64Attribute{
+				Description:         "The ID of the load balancer to which the servers should be assigned.",
+				MarkdownDescription: "The ID of the load balancer to which the servers should be assigned.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"server_ids": schema.SetAttribute{
+				Description: "The IDs of the servers to assign to the load balancer. Servers that are removed from this " +
+					"list are removed from the load balancer.",
+				MarkdownDescription: "The IDs of the servers to assign to the load balancer. Servers that are removed from this " +
+					"list are removed from the load balancer.",
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *loadBalancerAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data loadBalancerAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	err := r.addServers(ctx, data.LoadBalancerId.ValueInt64(), serverIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating load balancer attachment", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *loadBalancerAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data loadBalancerAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	loadBalancerId := data.LoadBalancerId.ValueInt64()
+	tflog.Debug(ctx, fmt.Sprintf("Reading load balancer attachment: load_balancer_id=%d", loadBalancerId))
+	lbResp, err := r.bc.client.GetLoadBalancersLoadBalancerIdWithResponse(ctx, loadBalancerId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading load balancer attachment: load_balancer_id=%d", loadBalancerId),
+			err.Error(),
+		)
+		return
+	}
+	if lbResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Load balancer not found, removing attachment from state: load_balancer_id=%d", loadBalancerId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if lbResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code reading load balancer attachment",
+			fmt.Sprintf("Received %s reading load balancer attachment: load_balancer_id=%d. Details: %s", lbResp.Status(),
+				loadBalancerId, lbResp.Body))
+		return
+	}
+
+	// Only the servers managed by this attachment are tracked, any other servers assigned to the load balancer are ignored
+	assigned := lbResp.JSON200.LoadBalancer.ServerIds
+	serverIds = slices.DeleteFunc(serverIds, func(serverId int64) bool {
+		return !slices.Contains(assigned, serverId)
+	})
+
+	var diags diag.Diagnostics
+	data.ServerIds, diags = types.SetValueFrom(ctx, types.Int64Type, serverIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *loadBalancerAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state loadBalancerAttachmentResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planServerIds, stateServerIds []int64
+	resp.Diagnostics.Append(plan.ServerIds.ElementsAs(ctx, &planServerIds, false)...)
+	resp.Diagnostics.Append(state.ServerIds.ElementsAs(ctx, &stateServerIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added := slices.DeleteFunc(slices.Clone(planServerIds), func(serverId int64) bool {
+		return slices.Contains(stateServerIds, serverId)
+	})
+	removed := slices.DeleteFunc(slices.Clone(stateServerIds), func(serverId int64) bool {
+		return slices.Contains(planServerIds, serverId)
+	})
+
+	// Update API call logic
+	loadBalancerId := plan.LoadBalancerId.ValueInt64()
+	err := r.removeServers(ctx, loadBalancerId, removed)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating load balancer attachment", err.Error())
+		return
+	}
+	err = r.addServers(ctx, loadBalancerId, added)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating load balancer attachment", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *loadBalancerAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data loadBalancerAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serverIds []int64
+	resp.Diagnostics.Append(data.ServerIds.ElementsAs(ctx, &serverIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.removeServers(ctx, data.LoadBalancerId.ValueInt64(), serverIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting load balancer attachment", err.Error())
+		return
+	}
+}
+
+func (r *loadBalancerAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is in the format "load_balancer_id/server_id,server_id,..."
+	loadBalancerIdStr, serverIdsStr, found := strings.Cut(req.ID, "/")
+	loadBalancerId, err := strconv.ParseInt(loadBalancerIdStr, 10, 64)
+	if !found || err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing load balancer attachment",
+			fmt.Sprintf("Could not import load balancer attachment, expected import ID in the format "+
+				"\"load_balancer_id/server_id,server_id,...\", got: %s", req.ID),
+		)
+		return
+	}
+
+	var serverIds []int64
+	for _, serverIdStr := range strings.Split(serverIdsStr, ",") {
+		serverId, err := strconv.ParseInt(strings.TrimSpace(serverIdStr), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing load balancer attachment",
+				"Could not import load balancer attachment, unexpected error (server ID should be an integer): "+err.Error(),
+			)
+			return
+		}
+		serverIds = append(serverIds, serverId)
+	}
+
+	serverIdsSet, diags := types.SetValueFrom(ctx, types.Int64Type, serverIds)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), loadBalancerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_ids"), serverIdsSet)...)
+}
+
+func (r *loadBalancerAttachmentResource) addServers(ctx context.Context, loadBalancerId int64, serverIds []int64) error {
+	if len(serverIds) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adding servers to load balancer: load_balancer_id=%d, server_ids=%v", loadBalancerId, serverIds))
+	addResp, err := r.bc.client.PostLoadBalancersLoadBalancerIdServersWithResponse(ctx, loadBalancerId,
+		binarylane.PostLoadBalancersLoadBalancerIdServersJSONRequestBody{ServerIds: serverIds})
+	if err != nil {
+		return fmt.Errorf("error adding servers to load balancer: load_balancer_id=%d, error: %w", loadBalancerId, err)
+	}
+	if addResp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("unexpected HTTP status code adding servers to load balancer: load_balancer_id=%d, status=%s, details: %s",
+			loadBalancerId, addResp.Status(), addResp.Body)
+	}
+
+	return nil
+}
+
+func (r *loadBalancerAttachmentResource) removeServers(ctx context.Context, loadBalancerId int64, serverIds []int64) error {
+	if len(serverIds) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing servers from load balancer: load_balancer_id=%d, server_ids=%v", loadBalancerId, serverIds))
+	removeResp, err := r.bc.client.DeleteLoadBalancersLoadBalancerIdServersWithResponse(ctx, loadBalancerId,
+		binarylane.DeleteLoadBalancersLoadBalancerIdServersJSONRequestBody{ServerIds: serverIds})
+	if err != nil {
+		return fmt.Errorf("error removing servers from load balancer: load_balancer_id=%d, error: %w", loadBalancerId, err)
+	}
+	if removeResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Load balancer not found, assuming servers have been removed: load_balancer_id=%d", loadBalancerId))
+		return nil
+	}
+	if removeResp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("unexpected HTTP status code removing servers from load balancer: load_balancer_id=%d, status=%s, details: %s",
+			loadBalancerId, removeResp.Status(), removeResp.Body)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestLoadBalancerAttachmentResource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	servers := `
+resource "binarylane_server" "test" {
+  count             = 3
+  name              = "tf-test-lb-attachment-${count.index}"
+  region            = "per"
+  image             = "debian-12"
+  size              = "std-min"
+  password          = "` + password + `"
+  public_ipv4_count = 1
+}
+`
+
+	loadBalancer := func(name string) string {
+		return `
+resource "binarylane_load_balancer" "test" {
+  name              = "` + name + `"
+  region            = "per"
+  ignore_server_ids = true
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + servers + loadBalancer("tf-test-lb-attachment") + `
+resource "binarylane_load_balancer_attachment" "a" {
+  load_balancer_id = binarylane_load_balancer.test.id
+  server_ids       = [binarylane_server.test.0.id]
+}
+
+resource "binarylane_load_balancer_attachment" "b" {
+  load_balancer_id = binarylane_load_balancer.test.id
+  server_ids       = [binarylane_server.test.1.id]
+}
+
+data "binarylane_load_balancer" "test" {
+  depends_on = [binarylane_load_balancer_attachment.a, binarylane_load_balancer_attachment.b]
+
+  id = binarylane_load_balancer.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_load_balancer_attachment.a", "server_ids.#", "1"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer_attachment.b", "server_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "server_ids.#", "2"),
+					testCheckIfResourceAttrContainsAttr("data.binarylane_load_balancer.test", "server_ids", "binarylane_server.test.0", "id"),
+					testCheckIfResourceAttrContainsAttr("data.binarylane_load_balancer.test", "server_ids", "binarylane_server.test.1", "id"),
+				),
+			},
+			// Test import
+			{
+				ResourceName:      "binarylane_load_balancer_attachment.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["binarylane_load_balancer_attachment.a"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["load_balancer_id"], rs.Primary.Attributes["server_ids.0"]), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "load_balancer_id",
+			},
+			// Update one attachment, and remove the other, without affecting each other
+			{
+				Config: providerConfig + servers + loadBalancer("tf-test-lb-attachment") + `
+resource "binarylane_load_balancer_attachment" "a" {
+  load_balancer_id = binarylane_load_balancer.test.id
+  server_ids       = [binarylane_server.test.0.id, binarylane_server.test.2.id]
+}
+
+data "binarylane_load_balancer" "test" {
+  depends_on = [binarylane_load_balancer_attachment.a]
+
+  id = binarylane_load_balancer.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_load_balancer_attachment.a", "server_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "server_ids.#", "2"),
+					testCheckIfResourceAttrContainsAttr("data.binarylane_load_balancer.test", "server_ids", "binarylane_server.test.0", "id"),
+					testCheckIfResourceAttrContainsAttr("data.binarylane_load_balancer.test", "server_ids", "binarylane_server.test.2", "id"),
+				),
+			},
+			// Update the load balancer and an attachment in the same apply
+			{
+				Config: providerConfig + servers + loadBalancer("tf-test-lb-attachment-renamed") + `
+resource "binarylane_load_balancer_attachment" "a" {
+  load_balancer_id = binarylane_load_balancer.test.id
+  server_ids       = [binarylane_server.test.1.id]
+}
+
+data "binarylane_load_balancer" "test" {
+  depends_on = [binarylane_load_balancer_attachment.a]
+
+  id = binarylane_load_balancer.test.id
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("binarylane_load_balancer.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("binarylane_load_balancer.test", tfjsonpath.New("server_ids")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "name", "tf-test-lb-attachment-renamed"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer_attachment.a", "server_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "server_ids.#", "1"),
+					testCheckIfResourceAttrContainsAttr("data.binarylane_load_balancer.test", "server_ids", "binarylane_server.test.1", "id"),
+				),
+			},
+		},
+	})
+}
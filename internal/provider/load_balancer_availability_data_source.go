@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &loadBalancerAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &loadBalancerAvailabilityDataSource{}
+)
+
+func NewLoadBalancerAvailabilityDataSource() datasource.DataSource {
+	return &loadBalancerAvailabilityDataSource{}
+}
+
+type loadBalancerAvailabilityDataSource struct {
+	bc *BinarylaneClient
+}
+
+type loadBalancerAvailabilityDataSourceModel struct {
+	Options []loadBalancerAvailabilityOptionModel `tfsdk:"options"`
+}
+
+type loadBalancerAvailabilityOptionModel struct {
+	Anycast      types.Bool    `tfsdk:"anycast"`
+	PriceHourly  types.Float64 `tfsdk:"price_hourly"`
+	PriceMonthly types.Float64 `tfsdk:"price_monthly"`
+	Regions      types.List    `tfsdk:"regions"`
+}
+
+func (d *loadBalancerAvailabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_availability"
+}
+
+func (d *loadBalancerAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData))
+		return
+	}
+
+	d.bc = &bc
+}
+
+func (d *loadBalancerAvailabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the load balancer options that are available to create, including the regions they can " +
+			"be created in and their pricing.",
+		Attributes: map[string]schema.Attribute{
+			"options": schema.ListNestedAttribute{
+				Description:         "The available load balancer options.",
+				MarkdownDescription: "The available load balancer options.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"anycast": schema.BoolAttribute{
+							Description:         "If true this is an Anycast load balancer option.",
+							MarkdownDescription: "If true this is an Anycast load balancer option.",
+							Computed:            true,
+						},
+						"price_hourly": schema.Float64Attribute{
+							Description:         "Hourly price in AU$.",
+							MarkdownDescription: "Hourly price in AU$.",
+							Computed:            true,
+						},
+						"price_monthly": schema.Float64Attribute{
+							Description:         "Monthly price in AU$.",
+							MarkdownDescription: "Monthly price in AU$.",
+							Computed:            true,
+						},
+						"regions": schema.ListAttribute{
+							Description: "The slugs of regions where this load balancer option is available. If this is " +
+								"an Anycast load balancer option this will be null.",
+							MarkdownDescription: "The slugs of regions where this load balancer option is available. If this is " +
+								"an Anycast load balancer option this will be null.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *loadBalancerAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loadBalancerAvailabilityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Debug(ctx, "Reading load balancer availability")
+	options, err := d.bc.getLoadBalancerAvailability(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading load balancer availability", err.Error())
+		return
+	}
+
+	data.Options = make([]loadBalancerAvailabilityOptionModel, len(options))
+	for i, option := range options {
+		data.Options[i] = loadBalancerAvailabilityOptionModel{
+			Anycast:      types.BoolValue(option.Anycast),
+			PriceHourly:  types.Float64Value(option.PriceHourly),
+			PriceMonthly: types.Float64Value(option.PriceMonthly),
+			Regions:      types.ListNull(types.StringType),
+		}
+		if option.Regions != nil {
+			regions, diags := types.ListValueFrom(ctx, types.StringType, *option.Regions)
+			resp.Diagnostics.Append(diags...)
+			data.Options[i].Regions = regions
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (bc *BinarylaneClient) getLoadBalancerAvailability(ctx context.Context) ([]binarylane.LoadBalancerAvailabilityOption, error) {
+	resp, err := bc.client.GetLoadBalancersAvailabilityWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading load balancer availability, error: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code reading load balancer availability: status=%s, details: %s",
+			resp.Status(), resp.Body)
+	}
+	return resp.JSON200.LoadBalancerAvailabilityOptions, nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLoadBalancerAvailabilityDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "binarylane_load_balancer_availability" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.binarylane_load_balancer_availability.test", "options.#", func(value string) error {
+						count, err := strconv.Atoi(value)
+						if err != nil {
+							return err
+						}
+						if count < 1 {
+							return fmt.Errorf("expected at least one load balancer option, got: %d", count)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("data.binarylane_load_balancer_availability.test", "options.0.anycast"),
+					resource.TestCheckResourceAttrSet("data.binarylane_load_balancer_availability.test", "options.0.price_monthly"),
+				),
+			},
+			// Unsupported region is rejected at plan
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+  name   = "tf-test-lb-region"
+  region = "not-a-region"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Load balancer region not available"),
+			},
+		},
+	})
+}
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
 	"terraform-provider-binarylane/internal/resources"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &loadBalancerDataSource{}
-	_ datasource.DataSourceWithConfigure = &loadBalancerDataSource{}
+	_ datasource.DataSource                     = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &loadBalancerDataSource{}
 )
 
 func NewLoadBalancerDataSource() datasource.DataSource {
@@ -56,7 +62,7 @@ func (d *loadBalancerDataSource) Schema(ctx context.Context, req datasource.Sche
 	ds, err := convertResourceSchemaToDataSourceSchema(
 		loadBalancerSchema(ctx),
 		AttributeConfig{
-			RequiredAttributes: &[]string{"id"},
+			OptionalAttributes: &[]string{"id", "name"},
 		},
 	)
 	if err != nil {
@@ -64,8 +70,30 @@ func (d *loadBalancerDataSource) Schema(ctx context.Context, req datasource.Sche
 		return
 	}
 	resp.Schema = *ds
-	resp.Schema.Description = "Retrieve details about a BinaryLane Load Balancer."
+	resp.Schema.Description = "Retrieve details about a BinaryLane Load Balancer, by either `id` or `name`."
 	resp.Schema.MarkdownDescription = resp.Schema.Description
+
+	// Overrides
+	idDescription := "The ID of the load balancer to fetch. Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["id"] = schema.Int64Attribute{
+		Description:         idDescription,
+		MarkdownDescription: idDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+	nameDescription := "The name of the load balancer to fetch (case insensitive). Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription,
+		MarkdownDescription: nameDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+func (d *loadBalancerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
 }
 
 func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -78,6 +106,27 @@ func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	configName := data.Name
+
+	// Look up load balancer by name
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		loadBalancers, err := d.bc.listLoadBalancers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting load balancer: name=%s", name), err.Error())
+			return
+		}
+		id, diags := lookupId(loadBalancers,
+			func(lb binarylane.LoadBalancer) int64 { return lb.Id },
+			func(lb binarylane.LoadBalancer) bool { return strings.EqualFold(lb.Name, name) },
+			"load balancer", "name", name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
+
 	// Read API call logic
 	lbResp, err := d.bc.client.GetLoadBalancersLoadBalancerIdWithResponse(ctx, data.Id.ValueInt64())
 	if err != nil {
@@ -102,6 +151,11 @@ func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	// Names are matched case insensitively, so keep the configured name
+	if !configName.IsNull() {
+		data.Name = configName
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerForwardingRuleResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerForwardingRuleResource{}
+	_ resource.ResourceWithImportState = &loadBalancerForwardingRuleResource{}
+)
+
+func NewLoadBalancerForwardingRuleResource() resource.Resource {
+	return &loadBalancerForwardingRuleResource{}
+}
+
+type loadBalancerForwardingRuleResource struct {
+	bc *BinarylaneClient
+}
+
+type loadBalancerForwardingRuleResourceModel struct {
+	LoadBalancerId types.Int64  `tfsdk:"load_balancer_id"`
+	EntryProtocol  types.String `tfsdk:"entry_protocol"`
+}
+
+func (r *loadBalancerForwardingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *loadBalancerForwardingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_forwarding_rule"
+}
+
+func (r *loadBalancerForwardingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a single forwarding rule of a BinaryLane load balancer, without affecting any other " +
+			"forwarding rules of the load balancer. The load balancer should ignore changes to forwarding_rules, so " +
+			"that it does not remove the rule.",
+		MarkdownDescription: "Provides a single forwarding rule of a BinaryLane load balancer, without affecting any other " +
+			"forwarding rules of the load balancer. The `binarylane_load_balancer` should include `forwarding_rules` in " +
+			"`lifecycle.ignore_changes`, so that it does not remove the rule.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.Int64Attribute{
+				Description:         "The ID of the load balancer to which the forwarding rule should be added.",
+				MarkdownDescription: "The ID of the load balancer to which the forwarding rule should be added.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"entry_protocol": schema.StringAttribute{
+				Description:         "The protocol that traffic must match for the load balancer to forward it, either http or https.",
+				MarkdownDescription: "The protocol that traffic must match for the load balancer to forward it, either `http` or `https`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(binarylane.Http), string(binarylane.Https)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *loadBalancerForwardingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data loadBalancerForwardingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadBalancerId := data.LoadBalancerId.ValueInt64()
+	entryProtocol := binarylane.LoadBalancerRuleProtocol(data.EntryProtocol.ValueString())
+
+	// Check for an existing rule, so that a rule managed elsewhere is not taken over (and later removed) by this resource
+	lb, err := r.bc.getLoadBalancer(ctx, loadBalancerId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating load balancer forwarding rule", err.Error())
+		return
+	}
+	if lb == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("load_balancer_id"),
+			"Load balancer not found",
+			fmt.Sprintf("Could not find load balancer: load_balancer_id=%d", loadBalancerId),
+		)
+		return
+	}
+	if slices.ContainsFunc(lb.ForwardingRules, func(rule binarylane.ForwardingRule) bool { return rule.EntryProtocol == entryProtocol }) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entry_protocol"),
+			"Forwarding rule already exists",
+			fmt.Sprintf("The load balancer already has a forwarding rule: load_balancer_id=%d, entry_protocol=%s. "+
+				"Import the existing rule to manage it with this resource.", loadBalancerId, entryProtocol),
+		)
+		return
+	}
+
+	// Create API call logic
+	err = r.bc.addLoadBalancerForwardingRules(ctx, loadBalancerId,
+		[]binarylane.ForwardingRuleRequest{{EntryProtocol: entryProtocol}})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating load balancer forwarding rule", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *loadBalancerForwardingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data loadBalancerForwardingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	loadBalancerId := data.LoadBalancerId.ValueInt64()
+	entryProtocol := binarylane.LoadBalancerRuleProtocol(data.EntryProtocol.ValueString())
+	lb, err := r.bc.getLoadBalancer(ctx, loadBalancerId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading load balancer forwarding rule", err.Error())
+		return
+	}
+	if lb == nil || !slices.ContainsFunc(lb.ForwardingRules, func(rule binarylane.ForwardingRule) bool { return rule.EntryProtocol == entryProtocol }) {
+		tflog.Warn(ctx, fmt.Sprintf("Load balancer forwarding rule not found, removing from state: load_balancer_id=%d, entry_protocol=%s",
+			loadBalancerId, entryProtocol))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *loadBalancerForwardingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update
+	var data loadBalancerForwardingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *loadBalancerForwardingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data loadBalancerForwardingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.bc.removeLoadBalancerForwardingRules(ctx, data.LoadBalancerId.ValueInt64(),
+		[]binarylane.ForwardingRuleRequest{{EntryProtocol: binarylane.LoadBalancerRuleProtocol(data.EntryProtocol.ValueString())}})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting load balancer forwarding rule", err.Error())
+		return
+	}
+}
+
+func (r *loadBalancerForwardingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is in the format "load_balancer_id/entry_protocol"
+	loadBalancerIdStr, entryProtocol, found := strings.Cut(req.ID, "/")
+	loadBalancerId, err := strconv.ParseInt(loadBalancerIdStr, 10, 64)
+	if !found || err != nil || entryProtocol == "" {
+		resp.Diagnostics.AddError(
+			"Error importing load balancer forwarding rule",
+			fmt.Sprintf("Could not import load balancer forwarding rule, expected import ID in the format "+
+				"\"load_balancer_id/entry_protocol\", got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), loadBalancerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entry_protocol"), entryProtocol)...)
+}
+
+// getLoadBalancer returns the load balancer with the given ID, or nil if the load balancer does not exist.
+func (bc *BinarylaneClient) getLoadBalancer(ctx context.Context, loadBalancerId int64) (*binarylane.LoadBalancer, error) {
+	lbResp, err := bc.client.GetLoadBalancersLoadBalancerIdWithResponse(ctx, loadBalancerId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading load balancer: load_balancer_id=%d, error: %w", loadBalancerId, err)
+	}
+	if lbResp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if lbResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code reading load balancer: load_balancer_id=%d, status=%s, details: %s",
+			loadBalancerId, lbResp.Status(), lbResp.Body)
+	}
+
+	return &lbResp.JSON200.LoadBalancer, nil
+}
+
+// updateLoadBalancerForwardingRules adds and removes forwarding rules, so that the rules of the load balancer change
+// from prior to planned. New rules are added before stale rules are removed, so that traffic continues to be forwarded.
+func (bc *BinarylaneClient) updateLoadBalancerForwardingRules(
+	ctx context.Context, loadBalancerId int64, prior []binarylane.ForwardingRuleRequest, planned []binarylane.ForwardingRuleRequest,
+) error {
+	added := slices.DeleteFunc(slices.Clone(planned), func(rule binarylane.ForwardingRuleRequest) bool {
+		return slices.Contains(prior, rule)
+	})
+	removed := slices.DeleteFunc(slices.Clone(prior), func(rule binarylane.ForwardingRuleRequest) bool {
+		return slices.Contains(planned, rule)
+	})
+
+	err := bc.addLoadBalancerForwardingRules(ctx, loadBalancerId, added)
+	if err != nil {
+		return err
+	}
+	return bc.removeLoadBalancerForwardingRules(ctx, loadBalancerId, removed)
+}
+
+func (bc *BinarylaneClient) addLoadBalancerForwardingRules(
+	ctx context.Context, loadBalancerId int64, forwardingRules []binarylane.ForwardingRuleRequest,
+) error {
+	if len(forwardingRules) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adding forwarding rules to load balancer: load_balancer_id=%d, forwarding_rules=%v",
+		loadBalancerId, forwardingRules))
+	addResp, err := bc.client.PostLoadBalancersLoadBalancerIdForwardingRulesWithResponse(ctx, loadBalancerId,
+		binarylane.PostLoadBalancersLoadBalancerIdForwardingRulesJSONRequestBody{ForwardingRules: forwardingRules})
+	if err != nil {
+		return fmt.Errorf("error adding forwarding rules to load balancer: load_balancer_id=%d, error: %w", loadBalancerId, err)
+	}
+	if addResp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("unexpected HTTP status code adding forwarding rules to load balancer: load_balancer_id=%d, status=%s, details: %s",
+			loadBalancerId, addResp.Status(), addResp.Body)
+	}
+
+	return nil
+}
+
+func (bc *BinarylaneClient) removeLoadBalancerForwardingRules(
+	ctx context.Context, loadBalancerId int64, forwardingRules []binarylane.ForwardingRuleRequest,
+) error {
+	if len(forwardingRules) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing forwarding rules from load balancer: load_balancer_id=%d, forwarding_rules=%v",
+		loadBalancerId, forwardingRules))
+	removeResp, err := bc.client.DeleteLoadBalancersLoadBalancerIdForwardingRulesWithResponse(ctx, loadBalancerId,
+		binarylane.DeleteLoadBalancersLoadBalancerIdForwardingRulesJSONRequestBody{ForwardingRules: forwardingRules})
+	if err != nil {
+		return fmt.Errorf("error removing forwarding rules from load balancer: load_balancer_id=%d, error: %w", loadBalancerId, err)
+	}
+	if removeResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Load balancer not found, assuming forwarding rules have been removed: load_balancer_id=%d", loadBalancerId))
+		return nil
+	}
+	if removeResp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("unexpected HTTP status code removing forwarding rules from load balancer: load_balancer_id=%d, status=%s, details: %s",
+			loadBalancerId, removeResp.Status(), removeResp.Body)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestLoadBalancerForwardingRuleResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+  name   = "tf-test-lb-forwarding-rule"
+  region = "per"
+
+  lifecycle {
+    ignore_changes = [forwarding_rules]
+  }
+}
+
+resource "binarylane_load_balancer_forwarding_rule" "https" {
+  load_balancer_id = binarylane_load_balancer.test.id
+  entry_protocol   = "https"
+}
+
+data "binarylane_load_balancer" "test" {
+  depends_on = [binarylane_load_balancer_forwarding_rule.https]
+
+  id = binarylane_load_balancer.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_load_balancer_forwarding_rule.https", "load_balancer_id", "binarylane_load_balancer.test", "id"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer_forwarding_rule.https", "entry_protocol", "https"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "forwarding_rules.#", "2"),
+				),
+			},
+			// Test import
+			{
+				ResourceName:      "binarylane_load_balancer_forwarding_rule.https",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["binarylane_load_balancer_forwarding_rule.https"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["load_balancer_id"], rs.Primary.Attributes["entry_protocol"]), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "load_balancer_id",
+			},
+			// Remove the forwarding rule, without affecting the other rules of the load balancer
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+  name   = "tf-test-lb-forwarding-rule"
+  region = "per"
+
+  lifecycle {
+    ignore_changes = [forwarding_rules]
+  }
+}
+`,
+			},
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+  name   = "tf-test-lb-forwarding-rule"
+  region = "per"
+
+  lifecycle {
+    ignore_changes = [forwarding_rules]
+  }
+}
+
+data "binarylane_load_balancer" "test" {
+  id = binarylane_load_balancer.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "forwarding_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancer.test", "forwarding_rules.0.entry_protocol", "http"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &loadBalancerListResource{}
+	_ list.ListResourceWithConfigure = &loadBalancerListResource{}
+)
+
+func NewLoadBalancerListResource() list.ListResource {
+	return &loadBalancerListResource{}
+}
+
+type loadBalancerListResource struct {
+	bc *BinarylaneClient
+}
+
+type loadBalancerListResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Region types.String `tfsdk:"region"`
+}
+
+func (r *loadBalancerListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *loadBalancerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer"
+}
+
+func (r *loadBalancerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List existing BinaryLane load balancers, for use with `terraform query` to discover and import load balancers.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only list load balancers with this name (case insensitive).",
+				MarkdownDescription: "Only list load balancers with this name (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description:         "Only list load balancers in this region, e.g. `per`.",
+				MarkdownDescription: "Only list load balancers in this region, e.g. `per`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *loadBalancerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config loadBalancerListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing load balancers: name=%s, region=%s", config.Name.ValueString(), config.Region.ValueString()))
+	loadBalancers, err := r.bc.listLoadBalancers(ctx)
+	if err != nil {
+		diags.AddError("Error listing load balancers", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	loadBalancers = slices.DeleteFunc(loadBalancers, func(lb binarylane.LoadBalancer) bool {
+		if !config.Name.IsNull() && !strings.EqualFold(lb.Name, config.Name.ValueString()) {
+			return true
+		}
+		// Load balancers without a region are anycast load balancers
+		return !config.Region.IsNull() && (lb.Region == nil || !strings.EqualFold(lb.Region.Slug, config.Region.ValueString()))
+	})
+
+	stream.Results = listResults(req, loadBalancers, func(lb binarylane.LoadBalancer) list.ListResult {
+		return newListResult(ctx, req, lb.Id, lb.Name, func(data *loadBalancerResourceModel) diag.Diagnostics {
+			// Set default for imported resources
+			data.IgnoreServerIds = types.BoolValue(false)
+
+			return setLoadBalancerModelState(ctx, &data.loadBalancerDataModel, &lb)
+		})
+	})
+}
+
+func (bc *BinarylaneClient) listLoadBalancers(ctx context.Context) ([]binarylane.LoadBalancer, error) {
+	var items []binarylane.LoadBalancer
+	var page int32 = 1
+	perPage := int32(200)
+
+	for {
+		params := binarylane.GetLoadBalancersParams{
+			Page:    &page,
+			PerPage: &perPage,
+		}
+
+		listResp, err := bc.client.GetLoadBalancersWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing load balancers: page=%d, error: %w", page, err)
+		}
+		if listResp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status code listing load balancers: page=%d, status=%s, details: %s",
+				page, listResp.Status(), listResp.Body)
+		}
+
+		items = append(items, listResp.JSON200.LoadBalancers...)
+		if listResp.JSON200.Links == nil || listResp.JSON200.Links.Pages.Next == nil {
+			return items, nil
+		}
+
+		page++
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestLoadBalancerListResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+  name   = "tf-test-lb-list"
+  region = "per"
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "binarylane_load_balancer" "test" {
+  provider = binarylane
+
+  include_resource = true
+
+  config {
+    name   = "tf-test-lb-list"
+    region = "per"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("binarylane_load_balancer.test", 1),
+					querycheck.ExpectIdentity("binarylane_load_balancer.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceDisplayName("binarylane_load_balancer.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-lb-list")),
+						knownvalue.StringExact("tf-test-lb-list"),
+					),
+					querycheck.ExpectResourceKnownValues("binarylane_load_balancer.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-lb-list")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("region"),
+								KnownValue: knownvalue.StringExact("per"),
+							},
+							{
+								Path:       tfjsonpath.New("ignore_server_ids"),
+								KnownValue: knownvalue.Bool(false),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
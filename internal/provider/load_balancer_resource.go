@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"terraform-provider-binarylane/internal/binarylane"
 	"terraform-provider-binarylane/internal/resources"
 	"time"
@@ -16,9 +18,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,9 +31,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &loadBalancerResource{}
-	_ resource.ResourceWithConfigure   = &loadBalancerResource{}
-	_ resource.ResourceWithImportState = &loadBalancerResource{}
+	_ resource.Resource                   = &loadBalancerResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerResource{}
+	_ resource.ResourceWithIdentity       = &loadBalancerResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerResource{}
+	_ resource.ResourceWithModifyPlan     = &loadBalancerResource{}
 )
 
 func NewLoadBalancerResource() resource.Resource {
@@ -41,7 +49,8 @@ type loadBalancerResource struct {
 
 type loadBalancerResourceModel struct {
 	loadBalancerDataModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	IgnoreServerIds types.Bool     `tfsdk:"ignore_server_ids"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *loadBalancerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -122,6 +131,21 @@ func loadBalancerSchema(ctx context.Context) schema.Schema {
 		},
 	}
 
+	// Keep the defaults chosen by the API when health_check and server_ids are not configured, so that they are not
+	// planned as unknown (and the load balancer replaced in full) whenever another attribute is updated
+	healthCheck := s.Attributes["health_check"].(schema.SingleNestedAttribute)
+	for _, name := range []string{"path", "protocol"} {
+		attribute := healthCheck.Attributes[name].(schema.StringAttribute)
+		attribute.PlanModifiers = append(attribute.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+		healthCheck.Attributes[name] = attribute
+	}
+	healthCheck.PlanModifiers = append(healthCheck.PlanModifiers, objectplanmodifier.UseStateForUnknown())
+	s.Attributes["health_check"] = healthCheck
+
+	serverIds := s.Attributes["server_ids"].(schema.SetAttribute)
+	serverIds.PlanModifiers = append(serverIds.PlanModifiers, setplanmodifier.UseStateForUnknown())
+	s.Attributes["server_ids"] = serverIds
+
 	// Additional attributes
 	s.Attributes["ip"] = schema.StringAttribute{
 		Description:         "The IPv4 address of the load balancer.",
@@ -139,6 +163,19 @@ func loadBalancerSchema(ctx context.Context) schema.Schema {
 
 func (r *loadBalancerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = loadBalancerSchema(ctx)
+	resp.Schema.Description += " Creating a load balancer does not wait for its servers to pass the health check."
+	resp.Schema.MarkdownDescription += " Creating a load balancer does not wait for its servers to pass the health check."
+
+	ignoreServerIdsDescription := "If `true`, the servers assigned to the load balancer are not managed by this " +
+		"resource, so that they can be managed with `binarylane_load_balancer_attachment` resources instead. " +
+		"`server_ids` cannot be set, and will contain all servers currently assigned to the load balancer."
+	resp.Schema.Attributes["ignore_server_ids"] = schema.BoolAttribute{
+		Description:         ignoreServerIdsDescription,
+		MarkdownDescription: ignoreServerIdsDescription,
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
 
 	resp.Schema.Attributes["timeouts"] =
 		timeouts.Attributes(ctx, timeouts.Opts{
@@ -147,6 +184,88 @@ func (r *loadBalancerResource) Schema(ctx context.Context, req resource.SchemaRe
 		})
 }
 
+func (r *loadBalancerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idResourceIdentitySchema("The ID of the load balancer.")
+}
+
+func (r *loadBalancerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data loadBalancerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IgnoreServerIds.ValueBool() && !data.ServerIds.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_ids"),
+			"Unexpected server IDs",
+			"server_ids cannot be set when ignore_server_ids is true. Use binarylane_load_balancer_attachment "+
+				"resources to assign servers to the load balancer instead.",
+		)
+	}
+}
+
+func (r *loadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destruction plan
+		return
+	}
+
+	// When servers are managed by binarylane_load_balancer_attachment resources, the servers may be changed by an
+	// attachment in the same apply as an update, so the servers in state cannot be kept
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+		var ignoreServerIds types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ignore_server_ids"), &ignoreServerIds)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ignoreServerIds.ValueBool() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("server_ids"), types.SetUnknown(types.Int64Type))...)
+		}
+	}
+
+	if r.bc == nil {
+		// Provider is not yet configured
+		return
+	}
+
+	var region, stateRegion types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &stateRegion)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Anycast load balancers have no region, and an existing region has already been accepted by the API
+	if region.IsNull() || region.IsUnknown() || region.Equal(stateRegion) {
+		return
+	}
+
+	options, err := r.bc.getLoadBalancerAvailability(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading load balancer availability", err.Error())
+		return
+	}
+
+	var available []string
+	for _, option := range options {
+		if option.Regions != nil {
+			available = append(available, *option.Regions...)
+		}
+	}
+	if !slices.Contains(available, region.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Load balancer region not available",
+			fmt.Sprintf("Load balancers cannot be created in region %q. Available regions: %s. Leave region null to "+
+				"create an Anycast load balancer.", region.ValueString(), strings.Join(available, ", ")),
+		)
+	}
+}
+
 func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data loadBalancerResourceModel
 
@@ -171,10 +290,12 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	serverIds := []int64{}
-	diags = data.ServerIds.ElementsAs(ctx, &serverIds, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !data.IgnoreServerIds.ValueBool() {
+		diags = data.ServerIds.ElementsAs(ctx, &serverIds, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	body := binarylane.CreateLoadBalancerRequest{
@@ -216,6 +337,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	// Wait for load balancer to be ready
@@ -274,35 +396,111 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// Set default for imported resources
+	if data.IgnoreServerIds.IsNull() {
+		data.IgnoreServerIds = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data loadBalancerResourceModel
+	var data, state loadBalancerResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := data.Timeouts.Update(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// Update API call logic
 	forwardingRules := &[]binarylane.ForwardingRuleRequest{}
-	diags := data.LoadBalancerModel.ForwardingRules.ElementsAs(ctx, forwardingRules, true)
+	diags = data.LoadBalancerModel.ForwardingRules.ElementsAs(ctx, forwardingRules, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serverIds := &[]int64{}
-	diags = data.ServerIds.ElementsAs(ctx, serverIds, true)
+	priorForwardingRules := []binarylane.ForwardingRuleRequest{}
+	diags = state.LoadBalancerModel.ForwardingRules.ElementsAs(ctx, &priorForwardingRules, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Reconcile forwarding rules individually, so that traffic for unchanged rules is not interrupted
+	err := r.bc.updateLoadBalancerForwardingRules(ctx, data.Id.ValueInt64(), priorForwardingRules, *forwardingRules)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating load balancer forwarding rules", err.Error())
+		return
+	}
+
+	serverIdsChanged := !data.IgnoreServerIds.ValueBool() && !data.ServerIds.Equal(state.ServerIds)
+	if data.Name.Equal(state.Name) && data.HealthCheck.Equal(state.HealthCheck) && !serverIdsChanged {
+		// Nothing else has changed, so the load balancer does not need to be updated
+		lbResp, err := r.bc.client.GetLoadBalancersLoadBalancerIdWithResponse(ctx, data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading load balancer: name=%s", data.Name.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+		if lbResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Unexpected HTTP status code reading load balancer",
+				fmt.Sprintf("Received %s reading load balancer: name=%s. Details: %s", lbResp.Status(), data.Name.ValueString(), lbResp.Body),
+			)
+			return
+		}
+
+		diags = setLoadBalancerModelState(ctx, &data.loadBalancerDataModel, &lbResp.JSON200.LoadBalancer)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	serverIds := &[]int64{}
+	if data.IgnoreServerIds.ValueBool() {
+		// The whole pool is replaced by the update, so keep the servers that are currently assigned
+		currentResp, err := r.bc.client.GetLoadBalancersLoadBalancerIdWithResponse(ctx, data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading load balancer: name=%s", data.Name.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+		if currentResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Unexpected HTTP status code reading load balancer",
+				fmt.Sprintf("Received %s reading load balancer: name=%s. Details: %s", currentResp.Status(), data.Name.ValueString(), currentResp.Body),
+			)
+			return
+		}
+		*serverIds = currentResp.JSON200.LoadBalancer.ServerIds
+	} else {
+		diags = data.ServerIds.ElementsAs(ctx, serverIds, true)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Updating Load Balancer: name=%s", data.Name.ValueString()))
 
 	body := binarylane.UpdateLoadBalancerRequest{
@@ -315,11 +513,6 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		ServerIds: serverIds,
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, 20*time.Minute)
-	resp.Diagnostics.Append(diags...)
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
 	lbResp, err := r.bc.client.PutLoadBalancersLoadBalancerIdWithResponse(ctx, data.Id.ValueInt64(), body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -395,6 +588,12 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *loadBalancerResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	// Import by identity
+	if req.ID == "" {
+		importStateByIdentity(ctx, req, resp)
+		return
+	}
+
 	// Import by ID
 	id, err := strconv.ParseInt(req.ID, 10, 32)
 	if err == nil {
@@ -404,45 +603,18 @@ func (r *loadBalancerResource) ImportState(
 	}
 
 	// Import by name
-	var page int32 = 1
-	perPage := int32(200)
-	var loadBalancer binarylane.LoadBalancer
-	var nextPage bool = true
-
-	for nextPage { // Need to paginate because the API does not support filtering by name
-		params := binarylane.GetLoadBalancersParams{
-			Page:    &page,
-			PerPage: &perPage,
-		}
-
-		lbResp, err := r.bc.client.GetLoadBalancersWithResponse(ctx, &params)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error getting load balancer for import: name=%s", req.ID), err.Error())
-			return
-		}
-
-		if lbResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code getting load balancer for import",
-				fmt.Sprintf("Received %s reading load balancer: name=%s. Details: %s", lbResp.Status(), req.ID,
-					lbResp.Body))
-			return
-		}
+	loadBalancers, err := r.bc.listLoadBalancers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting load balancer for import: name=%s", req.ID), err.Error())
+		return
+	}
 
-		loadBalancers := lbResp.JSON200.LoadBalancers
-		for _, lb := range loadBalancers {
-			if lb.Name == req.ID {
-				loadBalancer = lb
-				nextPage = false
-				break
-			}
-		}
-		if lbResp.JSON200.Links == nil || lbResp.JSON200.Links.Pages.Next == nil {
-			nextPage = false
+	var loadBalancer binarylane.LoadBalancer
+	for _, lb := range loadBalancers {
+		if lb.Name == req.ID {
+			loadBalancer = lb
 			break
 		}
-
-		page++
 	}
 
 	diags := resp.State.SetAttribute(ctx, path.Root("id"), loadBalancer.Id)
@@ -12,7 +12,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestLoadBalancerResource(t *testing.T) {
@@ -46,8 +49,17 @@ data "binarylane_load_balancer" "test" {
 
   id = binarylane_load_balancer.test.id
 }
+
+data "binarylane_load_balancer" "by_name" {
+  depends_on = [binarylane_load_balancer.test]
+
+  name = "tf-test-lb"
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify lookup by name
+					resource.TestCheckResourceAttrPair("data.binarylane_load_balancer.by_name", "id", "binarylane_load_balancer.test", "id"),
+
 					// Verify resource values
 					resource.TestCheckResourceAttrSet("binarylane_load_balancer.test", "id"),
 					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "name", "tf-test-lb"),
@@ -88,6 +100,45 @@ data "binarylane_load_balancer" "test" {
 				ImportStateId:     "tf-test-lb",
 				ImportStateVerify: true,
 			},
+			// Add a forwarding rule only, which must not replace the health check or servers chosen at creation
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+  count             = 2
+  name              = "tf-test-lb-server-${count.index}"
+  region            = "per"
+  image             = "debian-12"
+  size              = "std-min"
+  password          = "` + password + `"
+  public_ipv4_count = 1
+}
+
+resource "binarylane_load_balancer" "test" {
+  name             = "tf-test-lb"
+  server_ids       = [binarylane_server.test.0.id, binarylane_server.test.1.id]
+  forwarding_rules = [{ entry_protocol = "http" }, { entry_protocol = "https" }]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("binarylane_load_balancer.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("binarylane_load_balancer.test",
+							tfjsonpath.New("health_check").AtMapKey("path"), knownvalue.StringExact("/")),
+						plancheck.ExpectKnownValue("binarylane_load_balancer.test",
+							tfjsonpath.New("health_check").AtMapKey("protocol"), knownvalue.StringExact("http")),
+						plancheck.ExpectKnownValue("binarylane_load_balancer.test",
+							tfjsonpath.New("server_ids"), knownvalue.SetSizeExact(2)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "forwarding_rules.#", "2"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "forwarding_rules.0.entry_protocol", "http"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "forwarding_rules.1.entry_protocol", "https"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "health_check.path", "/"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "health_check.protocol", "http"),
+					resource.TestCheckResourceAttr("binarylane_load_balancer.test", "server_ids.#", "2"),
+				),
+			},
 			{
 				Taint: []string{"binarylane_server.test[0]"},
 				Config: providerConfig + `
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &loadBalancersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &loadBalancersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &loadBalancersDataSource{}
+)
+
+func NewLoadBalancersDataSource() datasource.DataSource {
+	return &loadBalancersDataSource{}
+}
+
+type loadBalancersDataSource struct {
+	bc *BinarylaneClient
+}
+
+type loadBalancersDataSourceModel struct {
+	Name          types.String            `tfsdk:"name"`
+	NameRegex     types.String            `tfsdk:"name_regex"`
+	Region        types.String            `tfsdk:"region"`
+	LoadBalancers []loadBalancerDataModel `tfsdk:"load_balancers"`
+}
+
+func (d *loadBalancersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancers"
+}
+
+func (d *loadBalancersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData))
+		return
+	}
+
+	d.bc = &bc
+}
+
+func (d *loadBalancersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Each load balancer has the same attributes as the binarylane_load_balancer data source
+	lbResp := datasource.SchemaResponse{}
+	(&loadBalancerDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &lbResp)
+	resp.Diagnostics.Append(lbResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lbAttributes := lbResp.Schema.Attributes
+	lbAttributes["id"] = schema.Int64Attribute{
+		Description:         "The ID of the load balancer.",
+		MarkdownDescription: "The ID of the load balancer.",
+		Computed:            true,
+	}
+	lbAttributes["name"] = schema.StringAttribute{
+		Description:         "The hostname of the load balancer.",
+		MarkdownDescription: "The hostname of the load balancer.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the BinaryLane load balancers that match all of the specified filters. If no filters " +
+			"are specified, all load balancers in the account are returned.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only return the load balancer with this name (case insensitive).",
+				MarkdownDescription: "Only return the load balancer with this name (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description:         "Only return load balancers with a hostname that matches this regular expression (RE2 syntax).",
+				MarkdownDescription: "Only return load balancers with a hostname that matches this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description:         "Only return load balancers in this region, e.g. `per`. Anycast load balancers have no region, and are never returned when this is set.",
+				MarkdownDescription: "Only return load balancers in this region, e.g. `per`. Anycast load balancers have no region, and are never returned when this is set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"load_balancers": schema.ListNestedAttribute{
+				Description:         "The load balancers that match the filters, ordered by ID.",
+				MarkdownDescription: "The load balancers that match the filters, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: lbAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *loadBalancersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data loadBalancersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid regular expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loadBalancersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	// Read API call logic
+	tflog.Debug(ctx, "Listing load balancers")
+	loadBalancers, err := d.bc.listLoadBalancers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing load balancers", err.Error())
+		return
+	}
+
+	loadBalancers = slices.DeleteFunc(loadBalancers, func(lb binarylane.LoadBalancer) bool {
+		return !loadBalancerMatchesFilters(&data, nameRegex, &lb)
+	})
+	slices.SortFunc(loadBalancers, func(a, b binarylane.LoadBalancer) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	data.LoadBalancers = make([]loadBalancerDataModel, len(loadBalancers))
+	for i := range loadBalancers {
+		resp.Diagnostics.Append(setLoadBalancerModelState(ctx, &data.LoadBalancers[i], &loadBalancers[i])...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func loadBalancerMatchesFilters(data *loadBalancersDataSourceModel, nameRegex *regexp.Regexp, lb *binarylane.LoadBalancer) bool {
+	if !data.Name.IsNull() && !strings.EqualFold(lb.Name, data.Name.ValueString()) {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(lb.Name) {
+		return false
+	}
+	if !data.Region.IsNull() && (lb.Region == nil || !strings.EqualFold(lb.Region.Slug, data.Region.ValueString())) {
+		return false
+	}
+	return true
+}
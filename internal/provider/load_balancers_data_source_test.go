@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLoadBalancersDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "binarylane_load_balancer" "test" {
+	name   = "tf-test-lbs-data-source"
+	region = "per"
+}
+
+data "binarylane_load_balancers" "by_name" {
+	name = "TF-TEST-LBS-DATA-SOURCE"
+
+	depends_on = [binarylane_load_balancer.test]
+}
+
+data "binarylane_load_balancers" "by_name_regex" {
+	name_regex = "^tf-test-lbs-data-.*$"
+	region     = "per"
+
+	depends_on = [binarylane_load_balancer.test]
+}
+
+data "binarylane_load_balancers" "none" {
+	name_regex = "^tf-test-lbs-data-.*$"
+	region     = "syd"
+
+	depends_on = [binarylane_load_balancer.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_load_balancers.by_name", "load_balancers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.binarylane_load_balancers.by_name", "load_balancers.0.id", "binarylane_load_balancer.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancers.by_name", "load_balancers.0.name", "tf-test-lbs-data-source"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancers.by_name", "load_balancers.0.region", "per"),
+					resource.TestCheckResourceAttrPair("data.binarylane_load_balancers.by_name", "load_balancers.0.ip", "binarylane_load_balancer.test", "ip"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancers.by_name_regex", "load_balancers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.binarylane_load_balancers.by_name_regex", "load_balancers.0.id", "binarylane_load_balancer.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_load_balancers.none", "load_balancers.#", "0"),
+				),
+			},
+		},
+	})
+}
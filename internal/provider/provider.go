@@ -4,14 +4,24 @@ import (
 	"context"
 	"terraform-provider-binarylane/internal/binarylane"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ provider.Provider = (*binarylaneProvider)(nil)
+var (
+	_ provider.Provider                       = (*binarylaneProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*binarylaneProvider)(nil)
+	_ provider.ProviderWithActions            = (*binarylaneProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*binarylaneProvider)(nil)
+	_ provider.ProviderWithListResources      = (*binarylaneProvider)(nil)
+)
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -23,6 +33,10 @@ func New(version string) func() provider.Provider {
 
 type BinarylaneClient struct {
 	client *binarylane.ClientWithResponses
+	// serverLocks and vpcLocks are shared by all copies of the client, to serialise changes to server and VPC
+	// settings that are managed by more than one resource
+	serverLocks *keyedMutex
+	vpcLocks    *keyedMutex
 }
 
 type binarylaneProvider struct {
@@ -73,11 +87,16 @@ func (p *binarylaneProvider) Configure(ctx context.Context, req provider.Configu
 	}
 
 	binarylaneClient := BinarylaneClient{
-		client: client,
+		client:      client,
+		serverLocks: &keyedMutex{},
+		vpcLocks:    &keyedMutex{},
 	}
 
 	resp.DataSourceData = binarylaneClient
 	resp.ResourceData = binarylaneClient
+	resp.EphemeralResourceData = binarylaneClient
+	resp.ActionData = binarylaneClient
+	resp.ListResourceData = binarylaneClient
 }
 
 func (p *binarylaneProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -88,11 +107,16 @@ func (p *binarylaneProvider) Metadata(_ context.Context, _ provider.MetadataRequ
 func (p *binarylaneProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewServerDataSource,
+		NewServersDataSource,
 		NewServerFirewallRulesDataSource,
+		NewFirewallRulesSimulationDataSource,
+		NewServerKernelsDataSource,
 		NewSshKeyDataSource,
 		NewVpcDataSource,
 		NewVpcRouteEntriesDataSource,
 		NewLoadBalancerDataSource,
+		NewLoadBalancersDataSource,
+		NewLoadBalancerAvailabilityDataSource,
 		NewImagesDataSource,
 		NewRegionsDataSource,
 		NewSizesDataSource,
@@ -103,9 +127,57 @@ func (p *binarylaneProvider) Resources(_ context.Context) []func() resource.Reso
 	return []func() resource.Resource{
 		NewServerResource,
 		NewServerFirewallRulesResource,
+		NewServerFirewallRuleResource,
+		NewFirewallPolicyResource,
+		NewServerDiskResource,
+		NewServerPartnershipResource,
 		NewSshKeyResource,
 		NewVpcResource,
 		NewVpcRouteEntriesResource,
+		NewVpcRouteResource,
 		NewLoadBalancerResource,
+		NewLoadBalancerAttachmentResource,
+		NewLoadBalancerForwardingRuleResource,
+	}
+}
+
+func (p *binarylaneProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewServerConsoleEphemeralResource,
+		NewServerPasswordEphemeralResource,
+	}
+}
+
+func (p *binarylaneProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewServerRebootAction,
+		NewServerPowerCycleAction,
+		NewServerShutdownAction,
+		NewServerPowerOnAction,
+		NewServerPowerOffAction,
+		NewServerTakeBackupAction,
+		NewServerPingAction,
+		NewServerUptimeAction,
+		NewServerDisableSelinuxAction,
+		NewServerIsRunningAction,
+	}
+}
+
+func (p *binarylaneProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewValidMemoryFunction,
+		NewRoundMemoryFunction,
+		NewValidDiskFunction,
+		NewRoundDiskFunction,
+		NewCidrInVpcFunction,
+	}
+}
+
+func (p *binarylaneProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewServerListResource,
+		NewSshKeyListResource,
+		NewVpcListResource,
+		NewLoadBalancerListResource,
 	}
 }
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-binarylane/internal/binarylane"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &serverAction{}
+	_ action.ActionWithConfigure = &serverAction{}
+)
+
+// serverAction is a Terraform action that performs a server action which requires no options, such as a reboot.
+type serverAction struct {
+	bc *BinarylaneClient
+
+	// The type name of the action, excluding the provider type name prefix
+	typeName    string
+	description string
+	// Submits the server action and returns its ID
+	post func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error)
+}
+
+type serverActionModel struct {
+	ServerId types.Int64 `tfsdk:"server_id"`
+}
+
+func NewServerRebootAction() action.Action {
+	return &serverAction{
+		typeName: "_server_reboot",
+		description: "Request a BinaryLane server to perform a reboot. The server's operating system is asked to reboot " +
+			"gracefully, use `binarylane_server_power_cycle` to forcefully restart an unresponsive server.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsRebootWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsRebootJSONRequestBody{Type: "reboot"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerPowerCycleAction() action.Action {
+	return &serverAction{
+		typeName:    "_server_power_cycle",
+		description: "Power a BinaryLane server off and then on again. This is equivalent to pressing the reset button on a physical server.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsPowerCycleWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsPowerCycleJSONRequestBody{Type: "power_cycle"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerShutdownAction() action.Action {
+	return &serverAction{
+		typeName: "_server_shutdown",
+		description: "Request a BinaryLane server to shut down gracefully. Use `binarylane_server_power_off` to " +
+			"forcefully power off an unresponsive server.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsShutdownWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsShutdownJSONRequestBody{Type: "shutdown"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerPowerOnAction() action.Action {
+	return &serverAction{
+		typeName:    "_server_power_on",
+		description: "Power on a BinaryLane server.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsPowerOnWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsPowerOnJSONRequestBody{Type: "power_on"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerPowerOffAction() action.Action {
+	return &serverAction{
+		typeName: "_server_power_off",
+		description: "Forcefully power off a BinaryLane server. This is equivalent to removing the power cord from a " +
+			"physical server, use `binarylane_server_shutdown` to shut down the server gracefully.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsPowerOffWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsPowerOffJSONRequestBody{Type: "power_off"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerPingAction() action.Action {
+	return &serverAction{
+		typeName:    "_server_ping",
+		description: "Check whether a BinaryLane server responds to ping requests.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsPingWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsPingJSONRequestBody{Type: "ping"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerUptimeAction() action.Action {
+	return &serverAction{
+		typeName:    "_server_uptime",
+		description: "Retrieve the uptime of a BinaryLane server. Requires the QEMU guest agent to be installed on the server.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsUptimeWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsUptimeJSONRequestBody{Type: "uptime"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerIsRunningAction() action.Action {
+	return &serverAction{
+		typeName:    "_server_is_running",
+		description: "Check whether a BinaryLane server is currently running.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsIsRunningWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsIsRunningJSONRequestBody{Type: "is_running"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func NewServerDisableSelinuxAction() action.Action {
+	return &serverAction{
+		typeName: "_server_disable_selinux",
+		description: "Disable SELinux on a BinaryLane server, for servers that are unable to boot due to an SELinux " +
+			"misconfiguration. The server will be rebooted.",
+		post: func(ctx context.Context, bc *BinarylaneClient, serverId int64) (int64, error) {
+			actionResp, err := bc.client.PostServersServerIdActionsDisableSelinuxWithResponse(ctx, serverId,
+				binarylane.PostServersServerIdActionsDisableSelinuxJSONRequestBody{Type: "disable_selinux"})
+			if err != nil {
+				return 0, err
+			}
+			return serverActionId(actionResp.StatusCode(), actionResp.Status(), actionResp.Body, actionResp.JSON200)
+		},
+	}
+}
+
+func (a *serverAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.bc = &bc
+}
+
+func (a *serverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + a.typeName
+}
+
+func (a *serverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         a.description,
+		MarkdownDescription: a.description,
+		Attributes: map[string]schema.Attribute{
+			"server_id": serverActionServerIdAttribute(),
+		},
+	}
+}
+
+func (a *serverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data serverActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueInt64()
+
+	tflog.Info(ctx, fmt.Sprintf("Invoking server action: type=%s, server_id=%d", a.typeName, serverId))
+	actionId, err := a.post(ctx, a.bc, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error invoking server action: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+
+	err = waitForServerActionWithProgressEvents(ctx, a.bc, serverId, actionId, resp)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for server action", err.Error())
+		return
+	}
+}
+
+func serverActionServerIdAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description:         "The ID of the server on which to perform the action.",
+		MarkdownDescription: "The ID of the server on which to perform the action.",
+		Required:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func serverActionId(statusCode int, status string, body []byte, actionResp *binarylane.ActionResponse) (int64, error) {
+	if statusCode != http.StatusOK || actionResp == nil {
+		return 0, fmt.Errorf("unexpected HTTP status code submitting server action: status=%s, details: %s", status, body)
+	}
+	return actionResp.Action.Id, nil
+}
+
+// waitForServerActionWithProgressEvents waits for a server action to complete, sending a progress event to Terraform
+// whenever the progress of the action changes, and a final event with the result of the action (if any).
+func waitForServerActionWithProgressEvents(
+	ctx context.Context,
+	bc *BinarylaneClient,
+	serverId int64,
+	actionId int64,
+	resp *action.InvokeResponse,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	defer cancel()
+
+	lastMessage := ""
+	completedAction, err := bc.waitForServerActionProgress(ctx, serverId, actionId, func(inProgress *binarylane.Action) {
+		message := fmt.Sprintf("%s: %d%% complete", inProgress.Title, inProgress.Progress.PercentComplete)
+		if inProgress.Progress.CurrentStep != nil {
+			message += fmt.Sprintf(" (%s)", *inProgress.Progress.CurrentStep)
+		}
+		if message != lastMessage {
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+			lastMessage = message
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("%s: completed", completedAction.Title)
+	if completedAction.ResultData != nil {
+		message += fmt.Sprintf(", result: %s", *completedAction.ResultData)
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+
+	return nil
+}
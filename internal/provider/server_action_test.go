@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-binarylane/internal/binarylane"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestServerActions(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	server := `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-actions"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+`
+
+	actions := `
+action "binarylane_server_reboot" "test" {
+	config {
+		server_id = binarylane_server.test.id
+	}
+}
+
+action "binarylane_server_take_backup" "test" {
+	config {
+		server_id            = binarylane_server.test.id
+		replacement_strategy = "oldest"
+		backup_type          = "temporary"
+		label                = "tf-test-server-actions"
+	}
+}
+
+resource "terraform_data" "test" {
+	input = binarylane_server.test.id
+
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.binarylane_server_reboot.test, action.binarylane_server_take_backup.test]
+		}
+	}
+}
+`
+
+	powerOff := `
+action "binarylane_server_power_off" "test" {
+	config {
+		server_id = binarylane_server.test.id
+	}
+}
+
+resource "terraform_data" "power_off" {
+	input = binarylane_server.test.id
+
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.binarylane_server_power_off.test]
+		}
+	}
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Reboot and take a backup of the server after it is created
+			{
+				Config: providerConfig + server + actions,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "running"),
+					testCheckServerHasBackup("binarylane_server.test"),
+				),
+			},
+			// Power off the server
+			{
+				Config: providerConfig + server + actions + powerOff,
+			},
+			// Verify the power state of the server, which is refreshed before this step
+			{
+				Config: providerConfig + server + actions + powerOff,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckServerHasBackup verifies that at least one backup of the server exists
+func testCheckServerHasBackup(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		serverId, err := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid server ID: %s", rs.Primary.Attributes["id"])
+		}
+
+		client, err := binarylane.NewClientWithDefaultConfig()
+		if err != nil {
+			return fmt.Errorf("Error creating Binary Lane API client: %w", err)
+		}
+
+		backupsResp, err := client.GetServersServerIdBackupsWithResponse(context.Background(), serverId,
+			&binarylane.GetServersServerIdBackupsParams{})
+		if err != nil {
+			return fmt.Errorf("Error getting backups of server: server_id=%d, error: %w", serverId, err)
+		}
+		if backupsResp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("Unexpected status code getting backups of server: server_id=%d, details: %s",
+				serverId, backupsResp.Body)
+		}
+		if len(backupsResp.JSON200.Backups) == 0 {
+			return fmt.Errorf("expected a backup of server: server_id=%d", serverId)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &serverConsoleEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &serverConsoleEphemeralResource{}
+)
+
+func NewServerConsoleEphemeralResource() ephemeral.EphemeralResource {
+	return &serverConsoleEphemeralResource{}
+}
+
+type serverConsoleEphemeralResource struct {
+	bc *BinarylaneClient
+}
+
+type serverConsoleEphemeralResourceModel struct {
+	ServerId   types.Int64  `tfsdk:"server_id"`
+	BrowserUrl types.String `tfsdk:"browser_url"`
+	IframeUrl  types.String `tfsdk:"iframe_url"`
+	Expiry     types.String `tfsdk:"expiry"`
+}
+
+func (r *serverConsoleEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *serverConsoleEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_console"
+}
+
+func (r *serverConsoleEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generate time-limited URLs for the web console of a BinaryLane server. The URLs are never " +
+			"stored in Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server for which console URLs should be generated.",
+				MarkdownDescription: "The ID of the server for which console URLs should be generated.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"browser_url": schema.StringAttribute{
+				Description:         "The URL for the full screen and full featured version of the console.",
+				MarkdownDescription: "The URL for the full screen and full featured version of the console.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"iframe_url": schema.StringAttribute{
+				Description:         "The URL for the embedded version of the console.",
+				MarkdownDescription: "The URL for the embedded version of the console.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expiry": schema.StringAttribute{
+				Description:         "The expiry time of the console URLs, in RFC 3339 format.",
+				MarkdownDescription: "The expiry time of the console URLs, in RFC 3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *serverConsoleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data serverConsoleEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Open API call logic
+	tflog.Debug(ctx, fmt.Sprintf("Generating server console URLs: server_id=%d", data.ServerId.ValueInt64()))
+	consoleResp, err := r.bc.client.GetServersServerIdConsoleWithResponse(ctx, data.ServerId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error generating server console URLs: server_id=%d", data.ServerId.ValueInt64()),
+			err.Error(),
+		)
+		return
+	}
+	if consoleResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code generating server console URLs",
+			fmt.Sprintf("Received %s generating server console URLs: server_id=%d. Details: %s", consoleResp.Status(), data.ServerId.ValueInt64(), consoleResp.Body))
+		return
+	}
+
+	console := consoleResp.JSON200.Console
+	data.BrowserUrl = types.StringValue(console.Browser)
+	data.IframeUrl = types.StringValue(console.Iframe)
+	data.Expiry = types.StringValue(console.Expiry.Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -6,18 +6,23 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
 	"terraform-provider-binarylane/internal/resources"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &serverDataSource{}
-	_ datasource.DataSourceWithConfigure = &serverDataSource{}
+	_ datasource.DataSource                     = &serverDataSource{}
+	_ datasource.DataSourceWithConfigure        = &serverDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serverDataSource{}
 )
 
 func NewServerDataSource() datasource.DataSource {
@@ -30,15 +35,24 @@ type serverDataSource struct {
 
 type serverDataModel struct {
 	resources.ServerModel
-	PublicIpv4Addresses             types.List   `tfsdk:"public_ipv4_addresses"`
-	PrivateIPv4Addresses            types.List   `tfsdk:"private_ipv4_addresses"`
-	PublicIpv6Addresses             types.List   `tfsdk:"public_ipv6_addresses"`
-	PrivateIpv6Addresses            types.List   `tfsdk:"private_ipv6_addresses"`
-	Permalink                       types.String `tfsdk:"permalink"`
-	Memory                          types.Int32  `tfsdk:"memory"`
-	Disk                            types.Int32  `tfsdk:"disk"`
-	SourceAndDestinationCheck       types.Bool   `tfsdk:"source_and_destination_check"`
-	SeparatePrivateNetworkInterface types.Bool   `tfsdk:"separate_private_network_interface"`
+	PublicIpv4Addresses             types.List    `tfsdk:"public_ipv4_addresses"`
+	PrivateIPv4Addresses            types.List    `tfsdk:"private_ipv4_addresses"`
+	PublicIpv6Addresses             types.List    `tfsdk:"public_ipv6_addresses"`
+	PrivateIpv6Addresses            types.List    `tfsdk:"private_ipv6_addresses"`
+	Permalink                       types.String  `tfsdk:"permalink"`
+	Memory                          types.Int32   `tfsdk:"memory"`
+	Disk                            types.Int32   `tfsdk:"disk"`
+	SourceAndDestinationCheck       types.Bool    `tfsdk:"source_and_destination_check"`
+	SeparatePrivateNetworkInterface types.Bool    `tfsdk:"separate_private_network_interface"`
+	PowerState                      types.String  `tfsdk:"power_state"`
+	KernelId                        types.Int64   `tfsdk:"kernel_id"`
+	DailyBackups                    types.Int32   `tfsdk:"daily_backups"`
+	WeeklyBackups                   types.Int32   `tfsdk:"weekly_backups"`
+	MonthlyBackups                  types.Int32   `tfsdk:"monthly_backups"`
+	OffsiteBackups                  types.Bool    `tfsdk:"offsite_backups"`
+	Transfer                        types.Float64 `tfsdk:"transfer"`
+	PartnerId                       types.Int64   `tfsdk:"partner_id"`
+	FailoverIps                     types.List    `tfsdk:"failover_ips"`
 }
 
 func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -67,19 +81,41 @@ func (d *serverDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	ds, err := convertResourceSchemaToDataSourceSchema(
 		serverSchema(ctx),
 		AttributeConfig{
-			RequiredAttributes: &[]string{"id"},
-			ExcludedAttributes: &[]string{"password", "public_ipv4_count", "password_change_supported", "timeouts"},
+			OptionalAttributes: &[]string{"id", "name"},
+			ExcludedAttributes: &[]string{"password", "password_wo", "password_wo_version", "public_ipv4_count",
+				"password_change_supported", "reboot_triggers", "auto_reboot_on_feature_change", "timeouts"},
 		})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to convert resource schema to data source schema", err.Error())
 		return
 	}
 	resp.Schema = *ds
-	resp.Schema.Description = "Retrieve details about a BinaryLane Server."
+	resp.Schema.Description = "Retrieve details about a BinaryLane Server, by either `id` or `name`."
+
+	// Overrides
+	idDescription := "The ID of the server to fetch. Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["id"] = schema.Int64Attribute{
+		Description:         idDescription,
+		MarkdownDescription: idDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+	nameDescription := "The hostname of the server to fetch (case insensitive). Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription,
+		MarkdownDescription: nameDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+func (d *serverDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
 }
 
 func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var diag diag.Diagnostics
 	var data serverDataModel
 
 	// Read Terraform configuration data into the model
@@ -90,6 +126,27 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	configName := data.Name
+
+	// Look up server by hostname
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		servers, err := d.bc.listServers(ctx, &name)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting server: hostname=%s", name), err.Error())
+			return
+		}
+		id, diags := lookupId(servers,
+			func(s binarylane.Server) int64 { return s.Id },
+			func(s binarylane.Server) bool { return strings.EqualFold(s.Name, name) },
+			"server", "name", name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
+
 	// Read server
 	serverResp, err := d.bc.client.GetServersServerIdWithResponse(ctx, data.Id.ValueInt64())
 	if err != nil {
@@ -107,25 +164,64 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	data.Id = types.Int64Value(serverResp.JSON200.Server.Id)
-	data.Name = types.StringValue(serverResp.JSON200.Server.Name)
-	data.Image = types.StringValue(*serverResp.JSON200.Server.Image.Slug)
-	data.Region = types.StringValue(serverResp.JSON200.Server.Region.Slug)
-	data.Size = types.StringValue(serverResp.JSON200.Server.Size.Slug)
-	data.Backups = types.BoolValue(serverResp.JSON200.Server.NextBackupWindow != nil)
-	data.Ipv6 = types.BoolValue(len(serverResp.JSON200.Server.Networks.V6) > 0)
-	data.PortBlocking = types.BoolValue(serverResp.JSON200.Server.Networks.PortBlocking)
-	data.VpcId = types.Int64PointerValue(serverResp.JSON200.Server.VpcId)
-	data.Permalink = types.StringValue(*serverResp.JSON200.Server.Permalink)
-	data.Memory = types.Int32Value(serverResp.JSON200.Server.Memory)
-	data.Disk = types.Int32Value(serverResp.JSON200.Server.Disk)
-	data.SourceAndDestinationCheck = types.BoolPointerValue(serverResp.JSON200.Server.Networks.SourceAndDestinationCheck)
-	data.SeparatePrivateNetworkInterface = types.BoolPointerValue(serverResp.JSON200.Server.Networks.SeparatePrivateNetworkInterface)
-
-	if serverResp.JSON200.Server.VpcId == nil {
+	diags = setServerDataModelState(ctx, &data, &serverResp.JSON200.Server)
+	resp.Diagnostics.Append(diags...)
+
+	// Get user data script
+	userDataResp, err := d.bc.client.GetServersServerIdUserDataWithResponse(ctx, data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading server user data: id=%s, name=%s", data.Id.String(), data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+	if userDataResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected HTTP status %d reading server user data: name=%s, id=%s", userDataResp.StatusCode(), data.Name.ValueString(), data.Id.String()),
+			string(userDataResp.Body),
+		)
+		return
+	}
+	data.UserData = types.StringPointerValue(userDataResp.JSON200.UserData)
+
+	// Names are matched case insensitively, so keep the configured name
+	if !configName.IsNull() {
+		data.Name = configName
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setServerDataModelState sets the attributes of the server data model that are returned by the server endpoints,
+// excluding the user data script which must be requested separately.
+func setServerDataModelState(ctx context.Context, data *serverDataModel, server *binarylane.Server) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(server.Id)
+	data.Name = types.StringValue(server.Name)
+	data.Image = types.StringValue(*server.Image.Slug)
+	data.Region = types.StringValue(server.Region.Slug)
+	data.Size = types.StringValue(server.Size.Slug)
+	data.Backups = types.BoolValue(server.NextBackupWindow != nil)
+	data.Ipv6 = types.BoolValue(len(server.Networks.V6) > 0)
+	data.PortBlocking = types.BoolValue(server.Networks.PortBlocking)
+	data.VpcId = types.Int64PointerValue(server.VpcId)
+	data.Permalink = types.StringValue(*server.Permalink)
+	data.Memory = types.Int32Value(server.Memory)
+	data.Disk = types.Int32Value(server.Disk)
+	data.SourceAndDestinationCheck = types.BoolPointerValue(server.Networks.SourceAndDestinationCheck)
+	data.SeparatePrivateNetworkInterface = types.BoolPointerValue(server.Networks.SeparatePrivateNetworkInterface)
+	data.PowerState = serverPowerState(server.Status)
+	data.KernelId = serverKernelId(server.Kernel)
+	data.PartnerId = types.Int64PointerValue(server.PartnerId)
+	data.setSelectedSizeOptions(server.SelectedSizeOptions)
+
+	if server.VpcId == nil {
 		data.VpcIpv4Address = types.StringNull()
-	} else if len(serverResp.JSON200.Server.Networks.V4) > 0 {
-		for _, v4address := range serverResp.JSON200.Server.Networks.V4 {
+	} else if len(server.Networks.V4) > 0 {
+		for _, v4address := range server.Networks.V4 {
 			// Skip addresses in 172.21.0.0/16, these are BL internal addresses that are not part of the user's VPC
 			if v4address.Type == "private" && !strings.HasPrefix(v4address.IpAddress, "172.21.") {
 				data.VpcIpv4Address = types.StringValue(v4address.IpAddress)
@@ -134,7 +230,7 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		}
 	}
 
-	advFeat := serverResp.JSON200.Server.AdvancedFeatures.EnabledAdvancedFeatures
+	advFeat := server.AdvancedFeatures.EnabledAdvancedFeatures
 	data.AdvancedFeatures, diags = resources.NewAdvancedFeaturesValue(
 		resources.AdvancedFeaturesValue{}.AttributeTypes(ctx),
 		map[string]attr.Value{
@@ -156,7 +252,7 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	publicIpv4Addresses := []string{}
 	privateIpv4Addresses := []string{}
 
-	for _, v4address := range serverResp.JSON200.Server.Networks.V4 {
+	for _, v4address := range server.Networks.V4 {
 		if v4address.Type == "public" {
 			publicIpv4Addresses = append(publicIpv4Addresses, v4address.IpAddress)
 		} else {
@@ -180,10 +276,18 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.PrivateIPv4Addresses = tfPrivateIpv4Addresses
 	}
 
+	tfFailoverIps, diag := types.ListValueFrom(ctx, types.StringType, serverFailoverIps(server.FailoverIps))
+	diags.Append(diag...)
+	if diag.HasError() {
+		data.FailoverIps = types.ListUnknown(data.FailoverIps.ElementType(ctx))
+	} else {
+		data.FailoverIps = tfFailoverIps
+	}
+
 	publicIpv6Addresses := []string{}
 	privateIpv6Addresses := []string{}
 
-	for _, v6address := range serverResp.JSON200.Server.Networks.V6 {
+	for _, v6address := range server.Networks.V6 {
 		if v6address.Type == "public" {
 			publicIpv6Addresses = append(publicIpv6Addresses, v6address.IpAddress)
 		} else {
@@ -207,24 +311,22 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.PrivateIpv6Addresses = tfPrivateIpv6Addresses
 	}
 
-	// Get user data script
-	userDataResp, err := d.bc.client.GetServersServerIdUserDataWithResponse(ctx, data.Id.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading server user data: id=%s, name=%s", data.Id.String(), data.Name.ValueString()),
-			err.Error(),
-		)
-		return
-	}
-	if userDataResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Unexpected HTTP status %d reading server user data: name=%s, id=%s", userDataResp.StatusCode(), data.Name.ValueString(), data.Id.String()),
-			string(userDataResp.Body),
-		)
+	return diags
+}
+
+func (data *serverDataModel) setSelectedSizeOptions(options *binarylane.SelectedSizeOptions) {
+	if options == nil {
+		data.DailyBackups = types.Int32Null()
+		data.WeeklyBackups = types.Int32Null()
+		data.MonthlyBackups = types.Int32Null()
+		data.OffsiteBackups = types.BoolNull()
+		data.Transfer = types.Float64Null()
 		return
 	}
-	data.UserData = types.StringPointerValue(userDataResp.JSON200.UserData)
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	data.DailyBackups = types.Int32Value(options.DailyBackups)
+	data.WeeklyBackups = types.Int32Value(options.WeeklyBackups)
+	data.MonthlyBackups = types.Int32Value(options.MonthlyBackups)
+	data.OffsiteBackups = types.BoolValue(options.OffsiteBackups)
+	data.Transfer = types.Float64Value(options.Transfer)
 }
@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &serverDiskResource{}
+	_ resource.ResourceWithConfigure   = &serverDiskResource{}
+	_ resource.ResourceWithImportState = &serverDiskResource{}
+	_ resource.ResourceWithModifyPlan  = &serverDiskResource{}
+)
+
+func NewServerDiskResource() resource.Resource {
+	return &serverDiskResource{}
+}
+
+type serverDiskResource struct {
+	bc *BinarylaneClient
+}
+
+type serverDiskResourceModel struct {
+	Id            types.Int64    `tfsdk:"id"`
+	ServerId      types.Int64    `tfsdk:"server_id"`
+	SizeGigabytes types.Int64    `tfsdk:"size_gigabytes"`
+	Description   types.String   `tfsdk:"description"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *serverDiskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *serverDiskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_disk"
+}
+
+func (r *serverDiskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a BinaryLane secondary server disk resource. Secondary disks are allocated from the " +
+			"server's unallocated storage, and are retained when the server's operating system is rebuilt.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description:         "The ID of the disk.",
+				MarkdownDescription: "The ID of the disk.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server to which the disk is attached.",
+				MarkdownDescription: "The ID of the server to which the disk is attached.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"size_gigabytes": schema.Int64Attribute{
+				Description: "The size of the disk in GB. The server must have at least this much unallocated " +
+					"storage space. Disks may be grown, but cannot be shrunk.",
+				MarkdownDescription: "The size of the disk in GB. The server must have at least this much unallocated " +
+					"storage space. Disks may be grown, but cannot be shrunk.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for the disk. If this is not provided a default description will be added. " +
+					"Set to an empty string to prevent the default description being added. Changing the description " +
+					"will replace the disk.",
+				MarkdownDescription: "A description for the disk. If this is not provided a default description will be added. " +
+					"Set to an empty string to prevent the default description being added. Changing the description " +
+					"will replace the disk.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	resp.Schema.Attributes["timeouts"] = timeouts.Attributes(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+func (r *serverDiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serverDiskResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serverId := data.ServerId.ValueInt64()
+
+	// Record the existing disks, so that the new disk can be identified after it has been added
+	existingDisks, err := r.getServerDisks(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading existing server disks", err.Error())
+		return
+	}
+	existingDiskIds := make(map[int64]bool, len(existingDisks))
+	for _, disk := range existingDisks {
+		existingDiskIds[disk.Id] = true
+	}
+
+	// Leave the description null when it is not configured, so that the API assigns the default description
+	var description *string
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		description = data.Description.ValueStringPointer()
+	}
+
+	// Create API call logic
+	tflog.Debug(ctx, fmt.Sprintf("Adding disk to server: server_id=%d, size_gigabytes=%d", serverId, data.SizeGigabytes.ValueInt64()))
+	diskResp, err := r.bc.client.PostServersServerIdActionsAddDiskWithResponse(
+		ctx,
+		serverId,
+		binarylane.PostServersServerIdActionsAddDiskJSONRequestBody{
+			Type:          "add_disk",
+			SizeGigabytes: int32(data.SizeGigabytes.ValueInt64()),
+			Description:   description,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error adding disk to server: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+	if diskResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code adding disk to server",
+			fmt.Sprintf("Received %s adding disk to server: server_id=%d. Details: %s", diskResp.Status(), serverId, diskResp.Body))
+		return
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, diskResp.JSON200.Action.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for disk to be added to server", err.Error())
+		return
+	}
+
+	disks, err := r.getServerDisks(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server disks", err.Error())
+		return
+	}
+	// Prefer a new disk of the requested size, in case other disks were added to the server concurrently
+	var newDisk *binarylane.Disk
+	for i := range disks {
+		if existingDiskIds[disks[i].Id] {
+			continue
+		}
+		if newDisk == nil || int64(disks[i].SizeGigabytes) == data.SizeGigabytes.ValueInt64() {
+			newDisk = &disks[i]
+		}
+	}
+	if newDisk == nil {
+		resp.Diagnostics.AddError(
+			"Error finding new server disk",
+			fmt.Sprintf("The disk was added to the server, but could not be found in the server's disks: server_id=%d", serverId),
+		)
+		return
+	}
+
+	setServerDiskModelState(&data, newDisk)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverDiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverDiskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Debug(ctx, fmt.Sprintf("Reading server disk: server_id=%d, disk_id=%d", data.ServerId.ValueInt64(), data.Id.ValueInt64()))
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, data.ServerId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading server disk: server_id=%d, disk_id=%d", data.ServerId.ValueInt64(), data.Id.ValueInt64()),
+			err.Error(),
+		)
+		return
+	}
+	if serverResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Server not found, removing disk from state: server_id=%d, disk_id=%d", data.ServerId.ValueInt64(), data.Id.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code reading server disk",
+			fmt.Sprintf("Received %s reading server disk: server_id=%d, disk_id=%d. Details: %s",
+				serverResp.Status(), data.ServerId.ValueInt64(), data.Id.ValueInt64(), serverResp.Body))
+		return
+	}
+
+	var disk *binarylane.Disk
+	for i := range serverResp.JSON200.Server.Disks {
+		if serverResp.JSON200.Server.Disks[i].Id == data.Id.ValueInt64() {
+			disk = &serverResp.JSON200.Server.Disks[i]
+			break
+		}
+	}
+	if disk == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Server disk not found, removing from state: server_id=%d, disk_id=%d", data.ServerId.ValueInt64(), data.Id.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if disk.Primary {
+		resp.Diagnostics.AddError(
+			"Unable to manage primary server disk",
+			fmt.Sprintf("The disk is the primary disk of the server and cannot be managed as a binarylane_server_disk: server_id=%d, disk_id=%d. "+
+				"Use the disk attribute of binarylane_server to resize the primary disk.", data.ServerId.ValueInt64(), data.Id.ValueInt64()),
+		)
+		return
+	}
+
+	setServerDiskModelState(&data, disk)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverDiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverDiskResourceModel
+	var state serverDiskResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	serverId := state.ServerId.ValueInt64()
+	diskId := state.Id.ValueInt64()
+
+	// Update API call logic
+	if !plan.SizeGigabytes.Equal(state.SizeGigabytes) {
+		tflog.Debug(ctx, fmt.Sprintf("Resizing server disk: server_id=%d, disk_id=%d, size_gigabytes=%d", serverId, diskId, plan.SizeGigabytes.ValueInt64()))
+		diskResp, err := r.bc.client.PostServersServerIdActionsResizeDiskWithResponse(
+			ctx,
+			serverId,
+			binarylane.PostServersServerIdActionsResizeDiskJSONRequestBody{
+				Type:          "resize_disk",
+				DiskId:        diskId,
+				SizeGigabytes: int32(plan.SizeGigabytes.ValueInt64()),
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error resizing server disk: server_id=%d, disk_id=%d", serverId, diskId),
+				err.Error(),
+			)
+			return
+		}
+		if diskResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Unexpected HTTP status code resizing server disk",
+				fmt.Sprintf("Received %s resizing server disk: server_id=%d, disk_id=%d. Details: %s", diskResp.Status(), serverId, diskId, diskResp.Body))
+			return
+		}
+
+		err = r.bc.waitForServerAction(ctx, serverId, diskResp.JSON200.Action.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error waiting for server disk to be resized", err.Error())
+			return
+		}
+
+		state.SizeGigabytes = plan.SizeGigabytes
+	}
+	state.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serverDiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverDiskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	serverId := data.ServerId.ValueInt64()
+	diskId := data.Id.ValueInt64()
+
+	// Delete API call logic
+	tflog.Debug(ctx, fmt.Sprintf("Deleting server disk: server_id=%d, disk_id=%d", serverId, diskId))
+	diskResp, err := r.bc.client.PostServersServerIdActionsDeleteDiskWithResponse(
+		ctx,
+		serverId,
+		binarylane.PostServersServerIdActionsDeleteDiskJSONRequestBody{
+			Type:   "delete_disk",
+			DiskId: diskId,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting server disk: server_id=%d, disk_id=%d", serverId, diskId),
+			err.Error(),
+		)
+		return
+	}
+	if diskResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Server not found, assuming disk has been deleted: server_id=%d, disk_id=%d", serverId, diskId))
+		return
+	}
+	if diskResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code deleting server disk",
+			fmt.Sprintf("Received %s deleting server disk: server_id=%d, disk_id=%d. Details: %s", diskResp.Status(), serverId, diskId, diskResp.Body))
+		return
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, diskResp.JSON200.Action.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for server disk to be deleted", err.Error())
+		return
+	}
+}
+
+func (r *serverDiskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// Resource is being created or destroyed
+		return
+	}
+
+	var plan, state serverDiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SizeGigabytes.IsUnknown() || state.SizeGigabytes.IsNull() {
+		return
+	}
+
+	if plan.SizeGigabytes.ValueInt64() < state.SizeGigabytes.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size_gigabytes"),
+			"Disk cannot be shrunk",
+			fmt.Sprintf("The size of a server disk can only be increased. The disk is currently %d GB, but %d GB was planned. "+
+				"To reduce the size of the disk, the binarylane_server_disk must be destroyed and recreated, which will erase its data.",
+				state.SizeGigabytes.ValueInt64(), plan.SizeGigabytes.ValueInt64()),
+		)
+	}
+}
+
+func (r *serverDiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverIdStr, diskIdStr, found := strings.Cut(req.ID, "/")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Error importing server disk",
+			fmt.Sprintf("Could not import server disk, expected ID in the format <server_id>/<disk_id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	serverId, err := strconv.ParseInt(serverIdStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing server disk",
+			"Could not import server disk, unexpected error (server ID should be an integer): "+err.Error(),
+		)
+		return
+	}
+
+	diskId, err := strconv.ParseInt(diskIdStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing server disk",
+			"Could not import server disk, unexpected error (disk ID should be an integer): "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), diskId)...)
+}
+
+func (r *serverDiskResource) getServerDisks(ctx context.Context, serverId int64) ([]binarylane.Disk, error) {
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, serverId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading server: server_id=%d, error: %w", serverId, err)
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code reading server: server_id=%d, status=%s, details: %s",
+			serverId, serverResp.Status(), serverResp.Body)
+	}
+
+	return serverResp.JSON200.Server.Disks, nil
+}
+
+func setServerDiskModelState(data *serverDiskResourceModel, disk *binarylane.Disk) {
+	data.Id = types.Int64Value(disk.Id)
+	data.SizeGigabytes = types.Int64Value(int64(disk.SizeGigabytes))
+	if disk.Description == nil {
+		data.Description = types.StringValue("")
+	} else {
+		data.Description = types.StringValue(*disk.Description)
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestServerDiskResource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	serverConfig := `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-disk"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-1vcpu"
+	disk              = 20
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + serverConfig + `
+resource "binarylane_server_disk" "test" {
+	server_id      = binarylane_server.test.id
+	size_gigabytes = 5
+	description    = "tf-test-data"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_server_disk.test", "server_id", "binarylane_server.test", "id"),
+					resource.TestCheckResourceAttrSet("binarylane_server_disk.test", "id"),
+					resource.TestCheckResourceAttr("binarylane_server_disk.test", "size_gigabytes", "5"),
+					resource.TestCheckResourceAttr("binarylane_server_disk.test", "description", "tf-test-data"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "binarylane_server_disk.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resourceState := s.RootModule().Resources["binarylane_server_disk.test"]
+					return fmt.Sprintf("%s/%s", resourceState.Primary.Attributes["server_id"], resourceState.Primary.Attributes["id"]), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + serverConfig + `
+resource "binarylane_server_disk" "test" {
+	server_id      = binarylane_server.test.id
+	size_gigabytes = 10
+	description    = "tf-test-data"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server_disk.test", "size_gigabytes", "10"),
+				),
+			},
+			// Plan should fail if the disk would be shrunk
+			{
+				Config: providerConfig + serverConfig + `
+resource "binarylane_server_disk" "test" {
+	server_id      = binarylane_server.test.id
+	size_gigabytes = 5
+	description    = "tf-test-data"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Disk cannot be shrunk"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+	"terraform-provider-binarylane/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &serverFirewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &serverFirewallRuleResource{}
+	_ resource.ResourceWithImportState = &serverFirewallRuleResource{}
+)
+
+func NewServerFirewallRuleResource() resource.Resource {
+	return &serverFirewallRuleResource{}
+}
+
+type serverFirewallRuleResource struct {
+	bc *BinarylaneClient
+}
+
+type serverFirewallRuleResourceModel struct {
+	ServerId             types.Int64  `tfsdk:"server_id"`
+	Description          types.String `tfsdk:"description"`
+	Action               types.String `tfsdk:"action"`
+	Protocol             types.String `tfsdk:"protocol"`
+	SourceAddresses      types.List   `tfsdk:"source_addresses"`
+	DestinationAddresses types.List   `tfsdk:"destination_addresses"`
+	DestinationPorts     types.List   `tfsdk:"destination_ports"`
+}
+
+func (r *serverFirewallRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *serverFirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_firewall_rule"
+}
+
+func (r *serverFirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Each attribute of the rule has the same description as the binarylane_server_firewall_rules resource
+	rules := resources.ServerFirewallRulesResourceSchema(ctx).Attributes["firewall_rules"].(schema.ListNestedAttribute)
+	rule := rules.NestedObject.Attributes
+
+	resp.Schema = schema.Schema{
+		Description: "Provides a single External Firewall Rule of a BinaryLane server, without affecting any other " +
+			"firewall rules of the server. The rule is identified by its description, and is added after any existing " +
+			"rules. Do not use with binarylane_server_firewall_rules for the same server, which would remove the rule.",
+		MarkdownDescription: "Provides a single External Firewall Rule of a BinaryLane server, without affecting any other " +
+			"firewall rules of the server. The rule is identified by its `description`, and is added after any existing " +
+			"rules. Do not use with `binarylane_server_firewall_rules` for the same server, which would remove the rule.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server to which the firewall rule should be added.",
+				MarkdownDescription: "The ID of the server to which the firewall rule should be added.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: rule["description"].GetDescription() + " Must be unique among the firewall rules of " +
+					"the server, as it identifies the rule managed by this resource.",
+				MarkdownDescription: rule["description"].GetMarkdownDescription() + " Must be unique among the " +
+					"firewall rules of the server, as it identifies the rule managed by this resource.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description:         rule["action"].GetDescription(),
+				MarkdownDescription: rule["action"].GetMarkdownDescription(),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(binarylane.Drop), string(binarylane.Accept)),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description:         rule["protocol"].GetDescription(),
+				MarkdownDescription: rule["protocol"].GetMarkdownDescription(),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(binarylane.All),
+						string(binarylane.Icmp),
+						string(binarylane.Tcp),
+						string(binarylane.Udp),
+					),
+				},
+			},
+			"source_addresses": schema.ListAttribute{
+				Description:         rule["source_addresses"].GetDescription(),
+				MarkdownDescription: rule["source_addresses"].GetMarkdownDescription(),
+				ElementType:         FirewallAddressType{}, // Ignore differences such as "10.0.0.1" and "10.0.0.1/32"
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+				},
+			},
+			"destination_addresses": schema.ListAttribute{
+				Description:         rule["destination_addresses"].GetDescription(),
+				MarkdownDescription: rule["destination_addresses"].GetMarkdownDescription(),
+				ElementType:         FirewallAddressType{}, // Ignore differences such as "10.0.0.1" and "10.0.0.1/32"
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+				},
+			},
+			"destination_ports": schema.ListAttribute{
+				Description:         rule["destination_ports"].GetDescription(),
+				MarkdownDescription: rule["destination_ports"].GetMarkdownDescription(),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(FirewallPortValidator{}),
+					FirewallPortsProtocolValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *serverFirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serverFirewallRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := data.firewallRuleRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueInt64()
+	description := data.Description.ValueString()
+
+	// Create API call logic
+	unlock := r.bc.serverLocks.lock(serverId)
+	defer unlock()
+
+	rules, err := r.bc.getServerFirewallRules(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating server firewall rule", err.Error())
+		return
+	}
+	if rules == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_id"),
+			"Server not found",
+			fmt.Sprintf("Could not find server: server_id=%d", serverId),
+		)
+		return
+	}
+	if findFirewallRule(*rules, description) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Firewall rule already exists",
+			fmt.Sprintf("The server already has a firewall rule: server_id=%d, description=%s. "+
+				"Import the existing rule to manage it with this resource.", serverId, description),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating server firewall rule: server_id=%d, description=%s", serverId, description))
+	err = r.bc.changeServerFirewallRules(ctx, serverId, append(firewallRuleRequests(*rules), rule))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating server firewall rule", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverFirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverFirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	serverId := data.ServerId.ValueInt64()
+	description := data.Description.ValueString()
+	rules, err := r.bc.getServerFirewallRules(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server firewall rule", err.Error())
+		return
+	}
+	var i int = -1
+	if rules != nil {
+		i = findFirewallRule(*rules, description)
+	}
+	if i < 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Server firewall rule not found, removing from state: server_id=%d, description=%s",
+			serverId, description))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setServerFirewallRuleModelState(ctx, &data, &(*rules)[i])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverFirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverFirewallRuleResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := plan.firewallRuleRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueInt64()
+
+	// Update API call logic
+	unlock := r.bc.serverLocks.lock(serverId)
+	defer unlock()
+
+	rules, err := r.bc.getServerFirewallRules(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating server firewall rule", err.Error())
+		return
+	}
+	var i int = -1
+	if rules != nil {
+		i = findFirewallRule(*rules, state.Description.ValueString())
+	}
+	if i < 0 {
+		resp.Diagnostics.AddError(
+			"Firewall rule not found",
+			fmt.Sprintf("Could not find firewall rule to update: server_id=%d, description=%s",
+				serverId, state.Description.ValueString()),
+		)
+		return
+	}
+	if !plan.Description.Equal(state.Description) && findFirewallRule(*rules, plan.Description.ValueString()) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Firewall rule already exists",
+			fmt.Sprintf("The server already has a firewall rule: server_id=%d, description=%s.",
+				serverId, plan.Description.ValueString()),
+		)
+		return
+	}
+
+	// Replace the rule in place, so that it keeps its position relative to the other rules
+	requests := firewallRuleRequests(*rules)
+	requests[i] = rule
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating server firewall rule: server_id=%d, description=%s",
+		serverId, plan.Description.ValueString()))
+	err = r.bc.changeServerFirewallRules(ctx, serverId, requests)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating server firewall rule", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serverFirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverFirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueInt64()
+	description := data.Description.ValueString()
+
+	// Delete API call logic
+	unlock := r.bc.serverLocks.lock(serverId)
+	defer unlock()
+
+	rules, err := r.bc.getServerFirewallRules(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting server firewall rule", err.Error())
+		return
+	}
+	if rules == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Server not found, assuming firewall rule has been removed: server_id=%d", serverId))
+		return
+	}
+	i := findFirewallRule(*rules, description)
+	if i < 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Server firewall rule not found, assuming it has been removed: server_id=%d, description=%s",
+			serverId, description))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting server firewall rule: server_id=%d, description=%s", serverId, description))
+	err = r.bc.changeServerFirewallRules(ctx, serverId, slices.Delete(firewallRuleRequests(*rules), i, i+1))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting server firewall rule", err.Error())
+		return
+	}
+}
+
+func (r *serverFirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is in the format "server_id/description"
+	serverIdStr, description, found := strings.Cut(req.ID, "/")
+	serverId, err := strconv.ParseInt(serverIdStr, 10, 64)
+	if !found || err != nil || description == "" {
+		resp.Diagnostics.AddError(
+			"Error importing server firewall rule",
+			fmt.Sprintf("Could not import server firewall rule, expected import ID in the format "+
+				"\"server_id/description\", got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), description)...)
+}
+
+func (data *serverFirewallRuleResourceModel) firewallRuleRequest(ctx context.Context) (binarylane.AdvancedFirewallRuleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rule := binarylane.AdvancedFirewallRuleRequest{
+		Description: data.Description.ValueStringPointer(),
+		Action:      binarylane.AdvancedFirewallRuleAction(data.Action.ValueString()),
+		Protocol:    binarylane.AdvancedFirewallRuleProtocol(data.Protocol.ValueString()),
+	}
+	diags.Append(data.SourceAddresses.ElementsAs(ctx, &rule.SourceAddresses, false)...)
+	diags.Append(data.DestinationAddresses.ElementsAs(ctx, &rule.DestinationAddresses, false)...)
+	if !data.DestinationPorts.IsNull() {
+		ports := []string{}
+		diags.Append(data.DestinationPorts.ElementsAs(ctx, &ports, false)...)
+		rule.DestinationPorts = &ports
+	}
+
+	return rule, diags
+}
+
+func setServerFirewallRuleModelState(ctx context.Context, data *serverFirewallRuleResourceModel, rule *binarylane.AdvancedFirewallRule) diag.Diagnostics {
+	var diags, diag diag.Diagnostics
+
+	data.Description = types.StringPointerValue(rule.Description)
+	data.Action = types.StringValue(string(rule.Action))
+	data.Protocol = types.StringValue(string(rule.Protocol))
+
+	data.SourceAddresses, diag = types.ListValueFrom(ctx, FirewallAddressType{}, rule.SourceAddresses)
+	diags.Append(diag...)
+
+	data.DestinationAddresses, diag = types.ListValueFrom(ctx, FirewallAddressType{}, rule.DestinationAddresses)
+	diags.Append(diag...)
+
+	// No ports and an empty list of ports both match all ports, so keep whichever was configured
+	if rule.DestinationPorts == nil || len(*rule.DestinationPorts) == 0 {
+		if data.DestinationPorts.IsUnknown() || len(data.DestinationPorts.Elements()) > 0 {
+			data.DestinationPorts = types.ListNull(types.StringType)
+		}
+	} else {
+		data.DestinationPorts, diag = types.ListValueFrom(ctx, types.StringType, *rule.DestinationPorts)
+		diags.Append(diag...)
+	}
+
+	return diags
+}
+
+// findFirewallRule returns the index of the rule with the given description, or -1 if there is no such rule.
+func findFirewallRule(rules []binarylane.AdvancedFirewallRule, description string) int {
+	return slices.IndexFunc(rules, func(rule binarylane.AdvancedFirewallRule) bool {
+		return rule.Description != nil && *rule.Description == description
+	})
+}
+
+func firewallRuleRequests(rules []binarylane.AdvancedFirewallRule) []binarylane.AdvancedFirewallRuleRequest {
+	requests := make([]binarylane.AdvancedFirewallRuleRequest, len(rules))
+	for i, rule := range rules {
+		requests[i] = binarylane.AdvancedFirewallRuleRequest(rule)
+	}
+	return requests
+}
+
+// getServerFirewallRules returns the advanced firewall rules of the server, or nil if the server does not exist.
+func (bc *BinarylaneClient) getServerFirewallRules(ctx context.Context, serverId int64) (*[]binarylane.AdvancedFirewallRule, error) {
+	fwResp, err := bc.client.GetServersServerIdAdvancedFirewallRulesWithResponse(ctx, serverId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading server firewall rules: server_id=%d, error: %w", serverId, err)
+	}
+	if fwResp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if fwResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code reading server firewall rules: server_id=%d, status=%s, details: %s",
+			serverId, fwResp.Status(), fwResp.Body)
+	}
+
+	return &fwResp.JSON200.FirewallRules, nil
+}
+
+// changeServerFirewallRules replaces all advanced firewall rules of the server, and waits for the change to complete.
+func (bc *BinarylaneClient) changeServerFirewallRules(
+	ctx context.Context, serverId int64, firewallRules []binarylane.AdvancedFirewallRuleRequest,
+) error {
+	fwResp, err := bc.client.PostServersServerIdActionsChangeAdvancedFirewallRulesWithResponse(
+		ctx,
+		serverId,
+		binarylane.PostServersServerIdActionsChangeAdvancedFirewallRulesJSONRequestBody{
+			Type:          "change_advanced_firewall_rules",
+			FirewallRules: firewallRules,
+		})
+	if err != nil {
+		return fmt.Errorf("error changing server firewall rules: server_id=%d, error: %w", serverId, err)
+	}
+	if fwResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code changing server firewall rules: server_id=%d, status=%s, details: %s",
+			serverId, fwResp.Status(), fwResp.Body)
+	}
+
+	return bc.waitForServerAction(ctx, serverId, fwResp.JSON200.Action.Id)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestServerFirewallRuleResource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	server := `
+resource "binarylane_server" "test" {
+  name              = "tf-test-server-fw-rule"
+  region            = "per"
+  image             = "debian-12"
+  size              = "std-min"
+  password          = "` + password + `"
+  public_ipv4_count = 0
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with both rules added to the same server concurrently
+			{
+				Config: providerConfig + server + `
+resource "binarylane_server_firewall_rule" "ssh" {
+  server_id             = binarylane_server.test.id
+  description           = "Allow SSH"
+  protocol              = "tcp"
+  source_addresses      = ["10.0.0.0/8"]
+  destination_addresses = [binarylane_server.test.private_ipv4_addresses.0]
+  destination_ports     = ["22"]
+  action                = "accept"
+}
+
+resource "binarylane_server_firewall_rule" "http" {
+  server_id             = binarylane_server.test.id
+  description           = "Allow HTTP"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = [binarylane_server.test.private_ipv4_addresses.0]
+  destination_ports     = ["80"]
+  action                = "accept"
+}
+
+data "binarylane_server_firewall_rules" "test" {
+  server_id = binarylane_server.test.id
+
+  depends_on = [binarylane_server_firewall_rule.ssh, binarylane_server_firewall_rule.http]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_server_firewall_rule.ssh", "server_id", "binarylane_server.test", "id"),
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.ssh", "description", "Allow SSH"),
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.ssh", "destination_ports.#", "1"),
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.ssh", "destination_ports.0", "22"),
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.http", "description", "Allow HTTP"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test", "firewall_rules.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "binarylane_server_firewall_rule.ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["binarylane_server_firewall_rule.ssh"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["server_id"], rs.Primary.Attributes["description"]), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "server_id",
+			},
+			// Update one rule and remove the other
+			{
+				Config: providerConfig + server + `
+resource "binarylane_server_firewall_rule" "ssh" {
+  server_id             = binarylane_server.test.id
+  description           = "Allow SSH from anywhere"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = [binarylane_server.test.private_ipv4_addresses.0]
+  destination_ports     = ["22"]
+  action                = "accept"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.ssh", "description", "Allow SSH from anywhere"),
+					resource.TestCheckResourceAttr("binarylane_server_firewall_rule.ssh", "source_addresses.0", "0.0.0.0/0"),
+				),
+			},
+			// Verify the remaining firewall rules of the server
+			{
+				Config: providerConfig + server + `
+resource "binarylane_server_firewall_rule" "ssh" {
+  server_id             = binarylane_server.test.id
+  description           = "Allow SSH from anywhere"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = [binarylane_server.test.private_ipv4_addresses.0]
+  destination_ports     = ["22"]
+  action                = "accept"
+}
+
+data "binarylane_server_firewall_rules" "test" {
+  server_id = binarylane_server.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test", "firewall_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_server_firewall_rules.test", "firewall_rules.0.description", "Allow SSH from anywhere"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestServerFirewallRuleResourceValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "binarylane_server_firewall_rule" "test" {
+  server_id             = 1
+  description           = "Invalid CIDR block"
+  protocol              = "tcp"
+  source_addresses      = ["10.0.0.0/33"]
+  destination_addresses = ["0.0.0.0/0"]
+  action                = "accept"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Firewall Address"),
+			},
+			{
+				Config: providerConfig + `
+resource "binarylane_server_firewall_rule" "test" {
+  server_id             = 1
+  description           = "Invalid port range"
+  protocol              = "tcp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = ["0.0.0.0/0"]
+  destination_ports     = ["8080-8000"]
+  action                = "accept"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Firewall Port"),
+			},
+			{
+				Config: providerConfig + `
+resource "binarylane_server_firewall_rule" "test" {
+  server_id             = 1
+  description           = "Ping with ports"
+  protocol              = "icmp"
+  source_addresses      = ["0.0.0.0/0"]
+  destination_addresses = ["0.0.0.0/0"]
+  destination_ports     = ["22"]
+  action                = "accept"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Firewall Ports"),
+			},
+		},
+	})
+}
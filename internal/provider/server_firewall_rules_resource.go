@@ -9,11 +9,13 @@ import (
 	"terraform-provider-binarylane/internal/resources"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	r_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -62,6 +64,37 @@ func (r *serverFirewallRulesResource) Schema(ctx context.Context, req resource.S
 	resp.Schema.Description = "Retrieve details about the External Firewall Rules assigned to a BinaryLane server."
 
 	// Overrides
+	firewallRules := resp.Schema.Attributes["firewall_rules"].(r_schema.ListNestedAttribute)
+	rule := firewallRules.NestedObject.Attributes
+	for _, name := range []string{"source_addresses", "destination_addresses"} {
+		addresses := rule[name].(r_schema.ListAttribute)
+		rule[name] = r_schema.ListAttribute{
+			Description:         addresses.GetDescription(),
+			MarkdownDescription: addresses.GetMarkdownDescription(),
+			ElementType:         FirewallAddressType{}, // Ignore differences such as "10.0.0.1" and "10.0.0.1/32"
+			Required:            true,
+			Validators: append(addresses.Validators,
+				listvalidator.ValueStringsAre(FirewallAddressValidator{}),
+			),
+		}
+	}
+	destinationPorts := rule["destination_ports"].(r_schema.ListAttribute)
+	destinationPorts.Validators = append(destinationPorts.Validators,
+		listvalidator.ValueStringsAre(FirewallPortValidator{}),
+		FirewallPortsProtocolValidator{},
+	)
+	rule["destination_ports"] = destinationPorts
+	resp.Schema.Attributes["firewall_rules"] = r_schema.ListNestedAttribute{
+		// Without the generated custom type, which requires addresses to be a list of strings
+		NestedObject:        r_schema.NestedAttributeObject{Attributes: rule},
+		Description:         firewallRules.GetDescription(),
+		MarkdownDescription: firewallRules.GetMarkdownDescription(),
+		Required:            true,
+		Validators: []validator.List{
+			FirewallRulesValidator{},
+		},
+	}
+
 	serverId := resp.Schema.Attributes["server_id"]
 	resp.Schema.Attributes["server_id"] = schema.Int64Attribute{
 		Description:         serverId.GetDescription(),
@@ -94,6 +127,9 @@ func (r *serverFirewallRulesResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	unlock := r.bc.serverLocks.lock(data.ServerId.ValueInt64())
+	defer unlock()
+
 	tflog.Debug(ctx, fmt.Sprintf("Creating server firewall rules: server_id=%s", data.ServerId.String()))
 	fwResp, err := r.bc.client.PostServersServerIdActionsChangeAdvancedFirewallRulesWithResponse(
 		ctx,
@@ -152,7 +188,7 @@ func (r *serverFirewallRulesResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	firewallRulesValue, diags := GetFirewallRulesState(ctx, &fwResp.JSON200.FirewallRules)
+	firewallRulesValue, diags := types.ListValueFrom(ctx, data.FirewallRules.ElementType(ctx), fwResp.JSON200.FirewallRules)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,6 +221,9 @@ func (r *serverFirewallRulesResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	unlock := r.bc.serverLocks.lock(data.ServerId.ValueInt64())
+	defer unlock()
+
 	tflog.Debug(ctx, fmt.Sprintf("Updating server firewall rules: server_id=%s", data.ServerId.String()))
 	serverResp, err := r.bc.client.PostServersServerIdActionsChangeAdvancedFirewallRulesWithResponse(
 		ctx,
@@ -222,6 +261,9 @@ func (r *serverFirewallRulesResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete API call logic
+	unlock := r.bc.serverLocks.lock(data.ServerId.ValueInt64())
+	defer unlock()
+
 	tflog.Debug(ctx, fmt.Sprintf("Deleting server firewall rules: server_id=%s", data.ServerId.String()))
 	serverResp, err := r.bc.client.PostServersServerIdActionsChangeAdvancedFirewallRulesWithResponse(
 		ctx,
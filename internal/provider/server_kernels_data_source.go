@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &serverKernelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverKernelsDataSource{}
+)
+
+func NewServerKernelsDataSource() datasource.DataSource {
+	return &serverKernelsDataSource{}
+}
+
+type serverKernelsDataSource struct {
+	bc *BinarylaneClient
+}
+
+type serverKernelsDataSourceModel struct {
+	ServerId types.Int64 `tfsdk:"server_id"`
+	Kernels  types.List  `tfsdk:"kernels"`
+}
+
+var serverKernelAttrTypes = map[string]attr.Type{
+	"id":      types.Int64Type,
+	"name":    types.StringType,
+	"version": types.StringType,
+}
+
+func (d *serverKernelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_kernels"
+}
+
+func (d *serverKernelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData))
+		return
+	}
+
+	d.bc = &bc
+}
+
+func (d *serverKernelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the kernels that are available to a BinaryLane server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server for which available kernels should be listed.",
+				MarkdownDescription: "The ID of the server for which available kernels should be listed.",
+				Required:            true,
+			},
+			"kernels": schema.ListNestedAttribute{
+				Description:         "The kernels available to the server.",
+				MarkdownDescription: "The kernels available to the server.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "The ID of this kernel.",
+							MarkdownDescription: "The ID of this kernel.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of this kernel.",
+							MarkdownDescription: "The name of this kernel.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							Description:         "The version (if any) of this kernel.",
+							MarkdownDescription: "The version (if any) of this kernel.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serverKernelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverKernelsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	kernels, err := listServerKernels(ctx, d.bc, data.ServerId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing server kernels", err.Error())
+		return
+	}
+
+	kernelValues := []attr.Value{}
+	for _, kernel := range kernels {
+		kernelValue, diags := types.ObjectValue(serverKernelAttrTypes, map[string]attr.Value{
+			"id":      types.Int64Value(kernel.Id),
+			"name":    types.StringPointerValue(kernel.Name),
+			"version": types.StringPointerValue(kernel.Version),
+		})
+		resp.Diagnostics.Append(diags...)
+		kernelValues = append(kernelValues, kernelValue)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kernelsValue, diags := types.ListValue(types.ObjectType{AttrTypes: serverKernelAttrTypes}, kernelValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Kernels = kernelsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listServerKernels(ctx context.Context, bc *BinarylaneClient, serverId int64) ([]binarylane.Kernel, error) {
+	var page int32 = 1
+	perPage := int32(200)
+	var nextPage bool = true
+	var kernels []binarylane.Kernel
+
+	for nextPage {
+		params := binarylane.GetServersServerIdKernelsParams{
+			Page:    &page,
+			PerPage: &perPage,
+		}
+		listResp, err := bc.client.GetServersServerIdKernelsWithResponse(ctx, serverId, &params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing kernels for server: server_id=%d, error: %w", serverId, err)
+		}
+		if listResp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status code listing kernels for server: server_id=%d, status=%s, details: %s",
+				serverId, listResp.Status(), listResp.Body)
+		}
+		kernels = append(kernels, listResp.JSON200.Kernels...)
+
+		if listResp.JSON200.Links == nil || listResp.JSON200.Links.Pages.Next == nil {
+			nextPage = false
+			break
+		}
+		page++
+	}
+
+	return kernels, nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestServerKernelsDataSource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-kernels"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+
+data "binarylane_server_kernels" "test" {
+	server_id = binarylane_server.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify data source values
+					resource.TestCheckResourceAttrPair("data.binarylane_server_kernels.test", "server_id", "binarylane_server.test", "id"),
+					resource.TestCheckResourceAttrWith("data.binarylane_server_kernels.test", "kernels.#", func(value string) error {
+						count, err := strconv.Atoi(value)
+						if err != nil {
+							return err
+						}
+						if count < 1 {
+							return fmt.Errorf("expected at least one kernel, got: %d", count)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("data.binarylane_server_kernels.test", "kernels.0.id"),
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "kernel_id"),
+				),
+			},
+			// Plan should fail if the kernel is not available to the server
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-kernels"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	kernel_id         = 999999999
+}
+
+data "binarylane_server_kernels" "test" {
+	server_id = binarylane_server.test.id
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Kernel not available"),
+			},
+		},
+	})
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &serverListResource{}
+	_ list.ListResourceWithConfigure = &serverListResource{}
+)
+
+func NewServerListResource() list.ListResource {
+	return &serverListResource{}
+}
+
+type serverListResource struct {
+	bc *BinarylaneClient
+}
+
+type serverListResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Region types.String `tfsdk:"region"`
+}
+
+func (r *serverListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *serverListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *serverListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List existing BinaryLane servers, for use with `terraform query` to discover and import servers.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only list the server with this hostname (case insensitive).",
+				MarkdownDescription: "Only list the server with this hostname (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description:         "Only list servers in this region, e.g. `per`.",
+				MarkdownDescription: "Only list servers in this region, e.g. `per`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *serverListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config serverListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing servers: name=%s, region=%s", config.Name.ValueString(), config.Region.ValueString()))
+	servers, err := r.bc.listServers(ctx, config.Name.ValueStringPointer())
+	if err != nil {
+		diags.AddError("Error listing servers", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !config.Region.IsNull() {
+		servers = slices.DeleteFunc(servers, func(server binarylane.Server) bool {
+			return !strings.EqualFold(server.Region.Slug, config.Region.ValueString())
+		})
+	}
+
+	stream.Results = listResults(req, servers, func(server binarylane.Server) list.ListResult {
+		return newListResult(ctx, req, server.Id, server.Name, func(data *serverResourceModel) diag.Diagnostics {
+			diags := setServerResourceState(ctx, data, &server)
+
+			// Set default for imported resources
+			data.AutoRebootOnFeatureChange = types.BoolValue(false)
+
+			userDataResp, err := r.bc.client.GetServersServerIdUserDataWithResponse(ctx, server.Id)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error reading server user data: id=%d, name=%s", server.Id, server.Name), err.Error())
+				return diags
+			}
+			if userDataResp.StatusCode() != http.StatusOK {
+				diags.AddError(
+					"Unexpected HTTP status code reading server user data",
+					fmt.Sprintf("Received %s reading server user data: id=%d, name=%s. Details: %s", userDataResp.Status(),
+						server.Id, server.Name, userDataResp.Body))
+				return diags
+			}
+			data.UserData = types.StringPointerValue(userDataResp.JSON200.UserData)
+
+			return diags
+		})
+	})
+}
+
+func (bc *BinarylaneClient) listServers(ctx context.Context, hostname *string) ([]binarylane.Server, error) {
+	var servers []binarylane.Server
+	var page int32 = 1
+	perPage := int32(200)
+
+	for {
+		params := binarylane.GetServersParams{
+			Hostname: hostname,
+			Page:     &page,
+			PerPage:  &perPage,
+		}
+
+		serversResp, err := bc.client.GetServersWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing servers: page=%d, error: %w", page, err)
+		}
+		if serversResp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status code listing servers: page=%d, status=%s, details: %s",
+				page, serversResp.Status(), serversResp.Body)
+		}
+
+		servers = append(servers, serversResp.JSON200.Servers...)
+		if serversResp.JSON200.Links == nil || serversResp.JSON200.Links.Pages.Next == nil {
+			return servers, nil
+		}
+
+		page++
+	}
+}
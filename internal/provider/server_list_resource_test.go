@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestServerListResource(t *testing.T) {
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+  name              = "tf-test-server-list"
+  region            = "per"
+  image             = "debian-12"
+  size              = "std-min"
+  password          = "` + password + `"
+  public_ipv4_count = 0
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "binarylane_server" "test" {
+  provider = binarylane
+
+  include_resource = true
+
+  config {
+    name   = "tf-test-server-list"
+    region = "per"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("binarylane_server.test", 1),
+					querycheck.ExpectIdentity("binarylane_server.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceDisplayName("binarylane_server.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-server-list")),
+						knownvalue.StringExact("tf-test-server-list"),
+					),
+					querycheck.ExpectResourceKnownValues("binarylane_server.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-server-list")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("region"),
+								KnownValue: knownvalue.StringExact("per"),
+							},
+							{
+								Path:       tfjsonpath.New("size"),
+								KnownValue: knownvalue.StringExact("std-min"),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
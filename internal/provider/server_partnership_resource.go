@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &serverPartnershipResource{}
+	_ resource.ResourceWithConfigure      = &serverPartnershipResource{}
+	_ resource.ResourceWithImportState    = &serverPartnershipResource{}
+	_ resource.ResourceWithValidateConfig = &serverPartnershipResource{}
+)
+
+func NewServerPartnershipResource() resource.Resource {
+	return &serverPartnershipResource{}
+}
+
+type serverPartnershipResource struct {
+	bc *BinarylaneClient
+}
+
+type serverPartnershipResourceModel struct {
+	ServerId           types.Int64 `tfsdk:"server_id"`
+	PartnerServerId    types.Int64 `tfsdk:"partner_server_id"`
+	FailoverIps        types.List  `tfsdk:"failover_ips"`
+	PartnerFailoverIps types.List  `tfsdk:"partner_failover_ips"`
+}
+
+func (r *serverPartnershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *serverPartnershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_partnership"
+}
+
+func (r *serverPartnershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a BinaryLane server partnership resource. Partnered servers are paired for high " +
+			"availability, allowing failover IP addresses to be moved between them. The partnership applies to both " +
+			"servers, so only one `binarylane_server_partnership` should be defined for each pair of servers.",
+		MarkdownDescription: "Provides a BinaryLane server partnership resource. Partnered servers are paired for high " +
+			"availability, allowing failover IP addresses to be moved between them. The partnership applies to both " +
+			"servers, so only one `binarylane_server_partnership` should be defined for each pair of servers.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server to partner.",
+				MarkdownDescription: "The ID of the server to partner.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"partner_server_id": schema.Int64Attribute{
+				Description:         "The ID of the partner server. The partner server must be in the same region as the server.",
+				MarkdownDescription: "The ID of the partner server. The partner server must be in the same region as the server.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"failover_ips": schema.ListAttribute{
+				Description:         "A list of any failover IPv4 addresses assigned to the server.",
+				MarkdownDescription: "A list of any failover IPv4 addresses assigned to the server.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"partner_failover_ips": schema.ListAttribute{
+				Description:         "A list of any failover IPv4 addresses assigned to the partner server.",
+				MarkdownDescription: "A list of any failover IPv4 addresses assigned to the partner server.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *serverPartnershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data serverPartnershipResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ServerId.IsUnknown() && !data.ServerId.IsNull() && data.ServerId.Equal(data.PartnerServerId) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("partner_server_id"),
+			"Invalid partner server",
+			"A server cannot be partnered with itself.",
+		)
+	}
+}
+
+func (r *serverPartnershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serverPartnershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	err := r.changePartner(ctx, data.ServerId.ValueInt64(), data.PartnerServerId.ValueInt64Pointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating server partnership", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.FailoverIps, diags = r.getFailoverIps(ctx, data.ServerId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	data.PartnerFailoverIps, diags = r.getFailoverIps(ctx, data.PartnerServerId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverPartnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverPartnershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Debug(ctx, fmt.Sprintf("Reading server partnership: server_id=%d", data.ServerId.ValueInt64()))
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, data.ServerId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading server partnership: server_id=%d", data.ServerId.ValueInt64()),
+			err.Error(),
+		)
+		return
+	}
+	if serverResp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, fmt.Sprintf("Server not found, removing partnership from state: server_id=%d", data.ServerId.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code reading server partnership",
+			fmt.Sprintf("Received %s reading server partnership: server_id=%d. Details: %s", serverResp.Status(), data.ServerId.ValueInt64(), serverResp.Body))
+		return
+	}
+
+	if serverResp.JSON200.Server.PartnerId == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Server has no partner, removing partnership from state: server_id=%d", data.ServerId.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.PartnerServerId = types.Int64PointerValue(serverResp.JSON200.Server.PartnerId)
+
+	var diags diag.Diagnostics
+	data.FailoverIps, diags = types.ListValueFrom(ctx, types.StringType, serverFailoverIps(serverResp.JSON200.Server.FailoverIps))
+	resp.Diagnostics.Append(diags...)
+	data.PartnerFailoverIps, diags = r.getFailoverIps(ctx, data.PartnerServerId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverPartnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update
+	var data serverPartnershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverPartnershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverPartnershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.changePartner(ctx, data.ServerId.ValueInt64(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting server partnership", err.Error())
+		return
+	}
+}
+
+func (r *serverPartnershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing server partnership",
+			"Could not import server partnership, unexpected error (ID should be an integer): "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("server_id"), id)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *serverPartnershipResource) changePartner(ctx context.Context, serverId int64, partnerServerId *int64) error {
+	if partnerServerId == nil {
+		tflog.Info(ctx, fmt.Sprintf("Removing partner from server: server_id=%d", serverId))
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Changing partner for server: server_id=%d, partner_server_id=%d", serverId, *partnerServerId))
+	}
+
+	partnerResp, err := r.bc.client.PostServersServerIdActionsChangePartnerWithResponse(
+		ctx,
+		serverId,
+		binarylane.PostServersServerIdActionsChangePartnerJSONRequestBody{
+			Type:            "change_partner",
+			PartnerServerId: partnerServerId,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error changing partner for server: server_id=%d, error: %w", serverId, err)
+	}
+	if partnerResp.StatusCode() == http.StatusNotFound && partnerServerId == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Server not found, assuming partnership has been removed: server_id=%d", serverId))
+		return nil
+	}
+	if partnerResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code changing partner for server: server_id=%d, status=%s, details: %s",
+			serverId, partnerResp.Status(), partnerResp.Body)
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, partnerResp.JSON200.Action.Id)
+	if err != nil {
+		return fmt.Errorf("error changing partner: %w", err)
+	}
+
+	return nil
+}
+
+func (r *serverPartnershipResource) getFailoverIps(ctx context.Context, serverId int64) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, serverId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading server failover IPs: server_id=%d", serverId), err.Error())
+		return types.ListUnknown(types.StringType), diags
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		diags.AddError(
+			"Unexpected HTTP status code reading server failover IPs",
+			fmt.Sprintf("Received %s reading server failover IPs: server_id=%d. Details: %s", serverResp.Status(), serverId, serverResp.Body))
+		return types.ListUnknown(types.StringType), diags
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, serverFailoverIps(serverResp.JSON200.Server.FailoverIps))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestServerPartnershipResource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	count             = 2
+	name              = "tf-test-server-partnership-${count.index}"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+
+resource "binarylane_server_partnership" "test" {
+	server_id         = binarylane_server.test[0].id
+	partner_server_id = binarylane_server.test[1].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_server_partnership.test", "server_id", "binarylane_server.test.0", "id"),
+					resource.TestCheckResourceAttrPair("binarylane_server_partnership.test", "partner_server_id", "binarylane_server.test.1", "id"),
+					resource.TestCheckResourceAttrSet("binarylane_server_partnership.test", "failover_ips.#"),
+					resource.TestCheckResourceAttrSet("binarylane_server_partnership.test", "partner_failover_ips.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "binarylane_server_partnership.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "server_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resourceState := s.RootModule().Resources["binarylane_server_partnership.test"]
+					return resourceState.Primary.Attributes["server_id"], nil
+				},
+			},
+			// Partner should be visible on both servers after refresh
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_server.test.0", "partner_id", "binarylane_server.test.1", "id"),
+					resource.TestCheckResourceAttrPair("binarylane_server.test.1", "partner_id", "binarylane_server.test.0", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/http"
+	"terraform-provider-binarylane/internal/binarylane"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &serverPasswordEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &serverPasswordEphemeralResource{}
+)
+
+const (
+	serverPasswordDefaultLength = 24
+	serverPasswordCharacters    = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+)
+
+func NewServerPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &serverPasswordEphemeralResource{}
+}
+
+type serverPasswordEphemeralResource struct {
+	bc *BinarylaneClient
+}
+
+type serverPasswordEphemeralResourceModel struct {
+	ServerId types.Int64  `tfsdk:"server_id"`
+	Username types.String `tfsdk:"username"`
+	Length   types.Int64  `tfsdk:"length"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *serverPasswordEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *serverPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_password"
+}
+
+func (r *serverPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reset the password of a user on a BinaryLane server to a newly generated value. The password is " +
+			"never stored in Terraform state. The password is reset each time the ephemeral resource is opened, which " +
+			"occurs during every plan and apply that references it. Only valid if the server supports password change actions.",
+		MarkdownDescription: "Reset the password of a user on a BinaryLane server to a newly generated value. The password is " +
+			"never stored in Terraform state. The password is reset each time the ephemeral resource is opened, which " +
+			"occurs during every plan and apply that references it. Only valid if the server supports password change " +
+			"actions, see the `password_change_supported` attribute of `binarylane_server`.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description:         "The ID of the server for which the password should be reset.",
+				MarkdownDescription: "The ID of the server for which the password should be reset.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description:         "The username of the user to change the password. If omitted, the default remote user of the server is used.",
+				MarkdownDescription: "The username of the user to change the password. If omitted, the default remote user of the server is used.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description:         fmt.Sprintf("The length of the generated password. Defaults to %d.", serverPasswordDefaultLength),
+				MarkdownDescription: fmt.Sprintf("The length of the generated password. Defaults to `%d`.", serverPasswordDefaultLength),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(12, 128),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "The generated password that has been set for the user.",
+				MarkdownDescription: "The generated password that has been set for the user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *serverPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data serverPasswordEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueInt64()
+
+	// Check that the server supports password changes before generating a password
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, serverId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading server: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code reading server",
+			fmt.Sprintf("Received %s reading server: server_id=%d. Details: %s", serverResp.Status(), serverId, serverResp.Body))
+		return
+	}
+	if !serverResp.JSON200.Server.PasswordChangeSupported {
+		image := "unknown"
+		if serverResp.JSON200.Server.Image.Slug != nil {
+			image = *serverResp.JSON200.Server.Image.Slug
+		}
+		resp.Diagnostics.AddError(
+			"Password change not supported",
+			fmt.Sprintf("The server does not support password change actions, so its password cannot be reset: server_id=%d, image=%s. "+
+				"The password can be changed via the web console instead.", serverId, image),
+		)
+		return
+	}
+
+	length := serverPasswordDefaultLength
+	if !data.Length.IsNull() {
+		length = int(data.Length.ValueInt64())
+	}
+	password, err := generateServerPassword(length)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating password", err.Error())
+		return
+	}
+	ctx = tflog.MaskMessageStrings(ctx, password)
+
+	// Open API call logic
+	tflog.Info(ctx, fmt.Sprintf("Resetting password for server: server_id=%d", serverId))
+	passwordResp, err := r.bc.client.PostServersServerIdActionsPasswordResetWithResponse(ctx, serverId,
+		binarylane.PasswordReset{
+			Type:     "password_reset",
+			Password: &password,
+			Username: data.Username.ValueStringPointer(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error resetting password: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+	if passwordResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP status code resetting password",
+			fmt.Sprintf("Received %s resetting password: server_id=%d. Details: %s", passwordResp.Status(), serverId, passwordResp.Body))
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+	err = r.bc.waitForServerAction(waitCtx, serverId, passwordResp.JSON200.Action.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for password reset", err.Error())
+		return
+	}
+
+	data.Password = types.StringValue(password)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func generateServerPassword(length int) (string, error) {
+	password := make([]byte, length)
+	charCount := big.NewInt(int64(len(serverPasswordCharacters)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, charCount)
+		if err != nil {
+			return "", fmt.Errorf("error generating random password: %w", err)
+		}
+		password[i] = serverPasswordCharacters[n.Int64()]
+	}
+	return string(password), nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,6 +41,7 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithIdentity    = &serverResource{}
 	_ resource.ResourceWithModifyPlan  = &serverResource{}
 )
 
@@ -51,13 +54,51 @@ type serverResource struct {
 	bc *BinarylaneClient
 }
 
+const (
+	serverPowerStateRunning = "running"
+	serverPowerStateStopped = "stopped"
+
+	// How long to wait for a graceful shutdown or reboot before the server is forcefully powered off or power cycled
+	serverShutdownTimeout = 2 * time.Minute
+
+	serverMemoryMinimum = 128
+	serverDiskMinimum   = 20
+)
+
+var (
+	// Rules for the valid values of memory (in MB), shared with the valid_memory and round_memory functions
+	serverMemoryMultiples = []MultipleOfValidator{
+		{Multiple: 128},
+		{Multiple: 1024, RangeFrom: 2048, RangeTo: 16384},
+		{Multiple: 2048, RangeFrom: 16384, RangeTo: 24576},
+		{Multiple: 4096, RangeFrom: 24576},
+	}
+	// Rules for the valid values of disk (in GB), shared with the valid_disk and round_disk functions
+	serverDiskMultiples = []MultipleOfValidator{
+		{Multiple: 5},
+		{Multiple: 10, RangeFrom: 60, RangeTo: 200},
+		{Multiple: 100, RangeFrom: 200},
+	}
+	// Rules for the valid values of transfer (in GB, although the transfer attribute is in TB)
+	serverTransferMultiples = []MultipleOfValidator{
+		{Multiple: 5},
+		{Multiple: 10, RangeFrom: 30},
+		{Multiple: 100, RangeFrom: 200},
+		{Multiple: 1000, RangeFrom: 2000},
+	}
+)
+
 type serverResourceModel struct {
 	serverDataModel
 
-	PublicIpv4Count         types.Int32    `tfsdk:"public_ipv4_count"`
-	Password                types.String   `tfsdk:"password"`
-	PasswordChangeSupported types.Bool     `tfsdk:"password_change_supported"`
-	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	PublicIpv4Count           types.Int32    `tfsdk:"public_ipv4_count"`
+	Password                  types.String   `tfsdk:"password"`
+	PasswordWo                types.String   `tfsdk:"password_wo"`
+	PasswordWoVersion         types.Int64    `tfsdk:"password_wo_version"`
+	PasswordChangeSupported   types.Bool     `tfsdk:"password_change_supported"`
+	RebootTriggers            types.Map      `tfsdk:"reboot_triggers"`
+	AutoRebootOnFeatureChange types.Bool     `tfsdk:"auto_reboot_on_feature_change"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *serverResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -195,6 +236,35 @@ func serverSchema(ctx context.Context) schema.Schema {
 		Optional:            true,  // Password optional, if not set will be emailed to user
 		Computed:            false, // Computed must be false to allow server to be created without password
 		Sensitive:           true,  // Mark password as sensitive
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+		},
+	}
+
+	pwWoDescription := "Write-only alternative to `password`, which is never stored in Terraform state. If this is provided " +
+		"the specified or default remote user's account password will be set to this value. Only valid if the server " +
+		"supports password change actions. Requires Terraform 1.11 or later. The password is only changed when " +
+		"`password_wo_version` changes."
+	s.Attributes["password_wo"] = schema.StringAttribute{
+		Description:         pwWoDescription,
+		MarkdownDescription: pwWoDescription,
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+		},
+	}
+
+	pwWoVersionDescription := "The version of `password_wo`. Because `password_wo` is not stored in Terraform state, " +
+		"this value must be changed to trigger a password reset with the new value of `password_wo`."
+	s.Attributes["password_wo_version"] = schema.Int64Attribute{
+		Description:         pwWoVersionDescription,
+		MarkdownDescription: pwWoVersionDescription,
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+		},
 	}
 
 	publicIpv4CountDescription := "The number of public IPv4 addresses to assign to the server."
@@ -315,6 +385,34 @@ func serverSchema(ctx context.Context) schema.Schema {
 		},
 	}
 
+	partnerIdDescription := "The ID of the partner server of this server, if one has been assigned. Partner servers can " +
+		"be assigned with the `binarylane_server_partnership` resource."
+	s.Attributes["partner_id"] = schema.Int64Attribute{
+		Description:         partnerIdDescription,
+		MarkdownDescription: partnerIdDescription,
+		// read only
+		Optional: false,
+		Required: false,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	failoverIpsDescription := "A list of any failover IPv4 addresses assigned to this server."
+	s.Attributes["failover_ips"] = schema.ListAttribute{
+		Description:         failoverIpsDescription,
+		MarkdownDescription: failoverIpsDescription,
+		ElementType:         types.StringType,
+		// read only
+		Optional: false,
+		Required: false,
+		Computed: true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+
 	pwChangeDescription := "If this is true then the `password` attribute can be changed with Terraform. " +
 		"If this is false then the `password` attribute can only be replaced with a null/empty value, which will clear " +
 		"the root/administrator password allowing the password to be changed via the web console."
@@ -346,13 +444,10 @@ func serverSchema(ctx context.Context) schema.Schema {
 		Optional:            true,
 		Required:            false,
 		Computed:            true,
-		Validators: []validator.Int32{
-			int32validator.AtLeast(128),
-			MultipleOfValidator{Multiple: 128},
-			MultipleOfValidator{Multiple: 1024, RangeFrom: 2048, RangeTo: 16384},
-			MultipleOfValidator{Multiple: 2048, RangeFrom: 16384, RangeTo: 24576},
-			MultipleOfValidator{Multiple: 4096, RangeFrom: 24576},
-		},
+		Validators: append(
+			[]validator.Int32{int32validator.AtLeast(serverMemoryMinimum)},
+			multipleOfInt32Validators(serverMemoryMultiples)...,
+		),
 	}
 
 	diskDescription := `The total storage in GB for this server. Leave null to accept the default for the size`
@@ -369,17 +464,148 @@ func serverSchema(ctx context.Context) schema.Schema {
 		Optional:            true,
 		Required:            false,
 		Computed:            true,
+		Validators: append(
+			[]validator.Int32{int32validator.AtLeast(serverDiskMinimum)},
+			multipleOfInt32Validators(serverDiskMultiples)...,
+		),
+	}
+
+	dailyBackupsDescription := "The number of retained daily backups. e.g. if this is `2`, two daily backups are stored, " +
+		"so each daily backup is retained for two days before being overwritten. Leave null to accept the default for the size."
+	s.Attributes["daily_backups"] = schema.Int32Attribute{
+		Description:         dailyBackupsDescription,
+		MarkdownDescription: dailyBackupsDescription,
+		Optional:            true,
+		Computed:            true,
 		Validators: []validator.Int32{
-			int32validator.AtLeast(20),
-			MultipleOfValidator{Multiple: 5},
-			MultipleOfValidator{Multiple: 10, RangeFrom: 60, RangeTo: 200},
-			MultipleOfValidator{Multiple: 100, RangeFrom: 200},
+			int32validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	weeklyBackupsDescription := "The number of retained weekly backups. e.g. if this is `1`, one weekly backup is stored, " +
+		"so that weekly backup is retained for one week before being overwritten. Leave null to accept the default for the size."
+	s.Attributes["weekly_backups"] = schema.Int32Attribute{
+		Description:         weeklyBackupsDescription,
+		MarkdownDescription: weeklyBackupsDescription,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	monthlyBackupsDescription := "The number of retained monthly backups. e.g. if this is `3`, three monthly backups are stored, " +
+		"so each monthly backup is retained for three months before being overwritten. Leave null to accept the default for the size."
+	s.Attributes["monthly_backups"] = schema.Int32Attribute{
+		Description:         monthlyBackupsDescription,
+		MarkdownDescription: monthlyBackupsDescription,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	offsiteBackupsDescription := "If `true`, any daily, weekly or monthly backups are duplicated to an off-site location. " +
+		"Leave null to accept the default for the size."
+	s.Attributes["offsite_backups"] = schema.BoolAttribute{
+		Description:         offsiteBackupsDescription,
+		MarkdownDescription: offsiteBackupsDescription,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	transferDescription := "The total transfer per month in TB for this server, including any extra transfer above what " +
+		"is included in the size. Leave null to accept the default for the size."
+	transferValidValues := " Valid values, when converted to GB, must be a multiple of 5. If the value is greater than 30 GB, " +
+		"it must be a multiple of 10. If the value is greater than 200 GB, it must be a multiple of 100. If the value is " +
+		"greater than 2000 GB, it must be a multiple of 1000."
+	transferValidValuesMarkdown := ` Valid values, when converted to GB:
+  - must be a multiple of 5
+  - \> 30 GB must be a multiple of 10
+  - \> 200 GB must be a multiple of 100
+  - \> 2000 GB must be a multiple of 1000`
+	s.Attributes["transfer"] = schema.Float64Attribute{
+		Description:         transferDescription + transferValidValues,
+		MarkdownDescription: transferDescription + transferValidValuesMarkdown,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Float64{
+			float64validator.AtLeast(0),
+			TransferValidator{Multiples: serverTransferMultiples},
+		},
+	}
+
+	powerStateDescription := "The desired power state of the server, either `running` or `stopped`. A server is shut down " +
+		"gracefully when stopped, and is powered off if it has not shut down within 2 minutes. If omitted, the current " +
+		"power state of the server is left unchanged."
+	s.Attributes["power_state"] = schema.StringAttribute{
+		Description:         powerStateDescription,
+		MarkdownDescription: powerStateDescription,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(serverPowerStateRunning, serverPowerStateStopped),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	kernelIdDescription := "The ID of the kernel used to boot the server. Available kernels can be listed with the " +
+		"`binarylane_server_kernels` data source. Leave null to use the default kernel for the image. Changes take " +
+		"effect the next time the server is rebooted."
+	s.Attributes["kernel_id"] = schema.Int64Attribute{
+		Description:         kernelIdDescription,
+		MarkdownDescription: kernelIdDescription,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
 		},
 	}
 
+	rebootTriggersDescription := "A map of arbitrary values that will reboot the server when any of them change, such as " +
+		"a hash of a configuration file. The server is rebooted gracefully, and is power cycled if it has not rebooted " +
+		"within 2 minutes. Servers that are `stopped`, or that are started or rebuilt by the same change, are not " +
+		"rebooted."
+	s.Attributes["reboot_triggers"] = schema.MapAttribute{
+		Description:         rebootTriggersDescription,
+		MarkdownDescription: rebootTriggersDescription,
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+
+	autoRebootDescription := "If `true`, the server will be rebooted after `advanced_features` are changed, so that the " +
+		"changes take effect immediately. By default, changes to `advanced_features` take effect the next time the " +
+		"server is rebooted."
+	s.Attributes["auto_reboot_on_feature_change"] = schema.BoolAttribute{
+		Description:         autoRebootDescription,
+		MarkdownDescription: autoRebootDescription,
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+
 	s.Attributes["timeouts"] = timeouts.Attributes(ctx, timeouts.Opts{
 		Create: true,
 		Update: true,
+		Delete: true,
 	})
 
 	vpcIpv4Addr := s.Attributes["vpc_ipv4_address"]
@@ -408,6 +634,10 @@ func (r *serverResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 	resp.Schema = serverSchema(ctx)
 }
 
+func (r *serverResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idResourceIdentitySchema("The ID of the server.")
+}
+
 func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var config, plan, state serverResourceModel
 
@@ -500,6 +730,33 @@ func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		)
 	}
 
+	// Rebuilding with a different image may change the default kernel
+	if !plan.Image.Equal(state.Image) && config.KernelId.IsNull() {
+		plan.KernelId = types.Int64Unknown()
+	}
+
+	// Check that the selected kernel is available to the server
+	if !config.KernelId.IsNull() && !config.KernelId.IsUnknown() && !plan.KernelId.Equal(state.KernelId) {
+		kernels, err := listServerKernels(ctx, r.bc, state.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error fetching available kernels for server: id=%s", state.Id.String()),
+				err.Error(),
+			)
+		} else if !slices.ContainsFunc(kernels, func(k binarylane.Kernel) bool { return k.Id == plan.KernelId.ValueInt64() }) {
+			availableKernelIds := []string{}
+			for _, kernel := range kernels {
+				availableKernelIds = append(availableKernelIds, strconv.FormatInt(kernel.Id, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("kernel_id"),
+				"Kernel not available",
+				fmt.Sprintf("Kernel %d is not available for server %s. Available kernel IDs: %s",
+					plan.KernelId.ValueInt64(), state.Name.String(), strings.Join(availableKernelIds, ", ")),
+			)
+		}
+	}
+
 	if !plan.Ipv6.Equal(state.Ipv6) {
 		if plan.Ipv6.ValueBool() {
 			plan.PublicIpv6Addresses = types.ListUnknown(state.PublicIpv6Addresses.ElementType(ctx))
@@ -510,13 +767,16 @@ func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		}
 	}
 
-	// Use state for unknown disk/memory values, as long as server size is the same
+	// Use state for unknown disk/memory/transfer values, as long as server size is the same
 	if (plan.Memory.IsNull() || plan.Memory.IsUnknown()) && plan.Size.Equal(state.Size) {
 		plan.Memory = state.Memory
 	}
 	if (plan.Disk.IsNull() || plan.Disk.IsUnknown()) && plan.Size.Equal(state.Size) {
 		plan.Disk = state.Disk
 	}
+	if (plan.Transfer.IsNull() || plan.Transfer.IsUnknown()) && plan.Size.Equal(state.Size) {
+		plan.Transfer = state.Transfer
+	}
 
 	if isAdvFeatChanged(&config.AdvancedFeatures, &state.AdvancedFeatures) {
 		advFeatResp, err := r.bc.client.GetServersServerIdAvailableAdvancedFeaturesWithResponse(ctx, state.Id.ValueInt64())
@@ -632,14 +892,32 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !data.Disk.IsNull() && !data.Disk.IsUnknown() {
 		body.Options.Disk = data.Disk.ValueInt32Pointer()
 	}
+	if !data.DailyBackups.IsNull() && !data.DailyBackups.IsUnknown() {
+		body.Options.DailyBackups = data.DailyBackups.ValueInt32Pointer()
+	}
+	if !data.WeeklyBackups.IsNull() && !data.WeeklyBackups.IsUnknown() {
+		body.Options.WeeklyBackups = data.WeeklyBackups.ValueInt32Pointer()
+	}
+	if !data.MonthlyBackups.IsNull() && !data.MonthlyBackups.IsUnknown() {
+		body.Options.MonthlyBackups = data.MonthlyBackups.ValueInt32Pointer()
+	}
+	if !data.OffsiteBackups.IsNull() && !data.OffsiteBackups.IsUnknown() {
+		body.Options.OffsiteBackups = data.OffsiteBackups.ValueBoolPointer()
+	}
+	if !data.Transfer.IsNull() && !data.Transfer.IsUnknown() {
+		body.Options.Transfer = data.Transfer.ValueFloat64Pointer()
+	}
 	if !data.VpcIpv4Address.IsNull() && !data.VpcIpv4Address.IsUnknown() {
 		body.VpcIpv4Address = data.VpcIpv4Address.ValueStringPointer()
 	}
-	if data.Password.IsNull() {
+	if !config.PasswordWo.IsNull() {
+		body.Password = config.PasswordWo.ValueStringPointer()
+		ctx = tflog.MaskMessageStrings(ctx, config.PasswordWo.ValueString())
+	} else if data.Password.IsNull() {
 		data.Password = types.StringNull()
 	} else {
 		body.Password = data.Password.ValueStringPointer()
-		ctx = tflog.MaskMessageStrings(ctx, data.Password.String())
+		ctx = tflog.MaskMessageStrings(ctx, data.Password.ValueString())
 	}
 
 	serverResp, err := r.bc.client.PostServersWithResponse(ctx, body)
@@ -672,7 +950,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 			fmt.Sprintf("Received %s creating new server: name=%s. Details: %s", serverResp.Status(), data.Name.ValueString(), serverResp.Body))
 		return
 	}
-	err = r.waitForServerAction(ctx, serverResp.JSON200.Server.Id, createActionId)
+	err = r.bc.waitForServerAction(ctx, serverResp.JSON200.Server.Id, createActionId)
 	if err != nil {
 		resp.Diagnostics.AddError("Error waiting for server to be created", err.Error())
 	}
@@ -696,6 +974,12 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	plannedSeparatePrivateNic := data.SeparatePrivateNetworkInterface
 	serverRespSeparatePrivateNic := types.BoolPointerValue(serverResp.JSON200.Server.Networks.SeparatePrivateNetworkInterface)
 	data.SeparatePrivateNetworkInterface = serverRespSeparatePrivateNic
+	plannedPowerState := data.PowerState
+	data.PowerState = serverPowerState(serverResp.JSON200.Server.Status)
+	plannedKernelId := data.KernelId
+	data.KernelId = serverKernelId(serverResp.JSON200.Server.Kernel)
+	data.PartnerId = types.Int64PointerValue(serverResp.JSON200.Server.PartnerId)
+	data.setSelectedSizeOptions(serverResp.JSON200.Server.SelectedSizeOptions)
 
 	if serverResp.JSON200.Server.VpcId == nil {
 		data.VpcIpv4Address = types.StringNull()
@@ -742,15 +1026,19 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(diags...)
 	data.PrivateIPv4Addresses, diags = types.ListValueFrom(ctx, types.StringType, privateIpv4Addresses)
 	resp.Diagnostics.Append(diags...)
+	data.FailoverIps, diags = types.ListValueFrom(ctx, types.StringType, serverFailoverIps(serverResp.JSON200.Server.FailoverIps))
+	resp.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update advanced features
-	err = r.updateAdvancedFeatures(ctx, data.Id.ValueInt64(), &config.AdvancedFeatures, &data.AdvancedFeatures)
+	autoReboot := data.AutoRebootOnFeatureChange.ValueBool() && plannedPowerState.ValueString() != serverPowerStateStopped
+	err = r.updateAdvancedFeatures(ctx, data.Id.ValueInt64(), &config.AdvancedFeatures, &data.AdvancedFeatures, autoReboot)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating advanced features", err.Error())
 	}
@@ -781,6 +1069,26 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.SeparatePrivateNetworkInterface = plannedSeparatePrivateNic
 	}
 
+	// Change kernel if needed
+	if !plannedKernelId.IsUnknown() && !plannedKernelId.IsNull() && !plannedKernelId.Equal(data.KernelId) {
+		err := r.updateKernel(ctx, data.Id.ValueInt64(), plannedKernelId.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error changing kernel", err.Error())
+			return
+		}
+		data.KernelId = plannedKernelId
+	}
+
+	// Power off the server if requested
+	if plannedPowerState.ValueString() == serverPowerStateStopped {
+		err := r.updatePowerState(ctx, data.Id.ValueInt64(), serverPowerStateStopped)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating power state", err.Error())
+			return
+		}
+		data.PowerState = plannedPowerState
+	}
+
 	// One extra read to check the final state of enabled_advanced_features, needed because
 	// some flags (like "cloud-init") are not set until the server is fully created. See #13
 	diag := r.fetchServerResourceState(ctx, &data)
@@ -805,6 +1113,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	diag := r.fetchServerResourceState(ctx, &data)
 	resp.Diagnostics.Append(diag...)
 
+	// Set default for imported resources
+	if data.AutoRebootOnFeatureChange.IsNull() {
+		data.AutoRebootOnFeatureChange = types.BoolValue(false)
+	}
+
 	// Get user data script
 	userDataResp, err := r.bc.client.GetServersServerIdUserDataWithResponse(ctx, data.Id.ValueInt64())
 	if err != nil {
@@ -824,6 +1137,7 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.UserData = types.StringPointerValue(userDataResp.JSON200.UserData)
 
 	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -846,6 +1160,11 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	rebuildNeeded := len(attrsRequiringRebuild(&plan, &state)) > 0
 	refreshNeeded := false
+	powerStateCheckNeeded := !plan.PowerState.IsUnknown() && !plan.PowerState.IsNull() && !plan.PowerState.Equal(state.PowerState)
+	// A server that is powered on or rebuilt by this update is booted fresh, so must not also be rebooted
+	poweringOn := state.PowerState.ValueString() == serverPowerStateStopped
+	rebootNeeded := !plan.RebootTriggers.IsNull() && !plan.RebootTriggers.Equal(state.RebootTriggers) &&
+		plan.PowerState.ValueString() != serverPowerStateStopped && !poweringOn && !rebuildNeeded
 
 	defer (func() {
 		if !refreshNeeded {
@@ -909,7 +1228,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				fmt.Sprintf("Received %s changing network for server: server_id=%s. Details: %s", networkResp.Status(), state.Id.String(), networkResp.Body))
 			return
 		}
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), networkResp.JSON200.Action.Id)
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), networkResp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for server to change network", err.Error())
 			return
@@ -957,7 +1276,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 					fmt.Sprintf("Received %s changing VPC IPv4 address for server: server_id=%s. Details: %s", vpcIpv4Resp.Status(), state.Id.String(), vpcIpv4Resp.Body))
 				return
 			}
-			err = r.waitForServerAction(ctx, state.Id.ValueInt64(), vpcIpv4Resp.JSON200.Action.Id)
+			err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), vpcIpv4Resp.JSON200.Action.Id)
 			if err != nil {
 				resp.Diagnostics.AddError("Error waiting for VPC IPv4 address to change", err.Error())
 				return
@@ -976,7 +1295,8 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		!plan.Memory.IsNull() && !plan.Memory.IsUnknown() && !plan.Memory.Equal(state.Memory) ||
 		!plan.Disk.IsNull() && !plan.Disk.IsUnknown() && !plan.Disk.Equal(state.Disk) ||
 		!plan.Image.Equal(state.Image) ||
-		!plan.PublicIpv4Count.Equal(state.PublicIpv4Count) {
+		!plan.PublicIpv4Count.Equal(state.PublicIpv4Count) ||
+		isSizeOptionsChanged(&plan.serverDataModel, &state.serverDataModel) {
 
 		resizeReq := &binarylane.PostServersServerIdActionsResizeJSONRequestBody{
 			Type:    "resize",
@@ -999,6 +1319,29 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			state.Disk = plan.Disk
 		}
 
+		if isSizeOptionsChanged(&plan.serverDataModel, &state.serverDataModel) {
+			if !plan.DailyBackups.IsNull() && !plan.DailyBackups.IsUnknown() {
+				resizeReq.Options.DailyBackups = plan.DailyBackups.ValueInt32Pointer()
+			}
+			if !plan.WeeklyBackups.IsNull() && !plan.WeeklyBackups.IsUnknown() {
+				resizeReq.Options.WeeklyBackups = plan.WeeklyBackups.ValueInt32Pointer()
+			}
+			if !plan.MonthlyBackups.IsNull() && !plan.MonthlyBackups.IsUnknown() {
+				resizeReq.Options.MonthlyBackups = plan.MonthlyBackups.ValueInt32Pointer()
+			}
+			if !plan.OffsiteBackups.IsNull() && !plan.OffsiteBackups.IsUnknown() {
+				resizeReq.Options.OffsiteBackups = plan.OffsiteBackups.ValueBoolPointer()
+			}
+			if !plan.Transfer.IsNull() && !plan.Transfer.IsUnknown() {
+				resizeReq.Options.Transfer = plan.Transfer.ValueFloat64Pointer()
+			}
+		}
+		state.DailyBackups = plan.DailyBackups
+		state.WeeklyBackups = plan.WeeklyBackups
+		state.MonthlyBackups = plan.MonthlyBackups
+		state.OffsiteBackups = plan.OffsiteBackups
+		state.Transfer = plan.Transfer
+
 		if !plan.Image.Equal(state.Image) {
 			resizeReq.ChangeImage = &binarylane.ChangeImage{
 				Image: plan.Image.ValueStringPointer(),
@@ -1047,13 +1390,15 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), resizeResp.JSON200.Action.Id)
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), resizeResp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for server to be resized", err.Error())
 			return
 		}
+		powerStateCheckNeeded = true // Resizing may restart the server
 
-		if state.PublicIpv4Addresses.IsUnknown() || listContainsUnknown(ctx, state.PublicIpv4Addresses) || state.Memory.IsNull() || state.Memory.IsUnknown() {
+		if state.PublicIpv4Addresses.IsUnknown() || listContainsUnknown(ctx, state.PublicIpv4Addresses) || state.Memory.IsNull() || state.Memory.IsUnknown() ||
+			state.Transfer.IsNull() || state.Transfer.IsUnknown() {
 			refreshNeeded = true
 		}
 
@@ -1079,7 +1424,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), ipv6Resp.JSON200.Action.Id)
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), ipv6Resp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for changing IPv6", err.Error())
 			return
@@ -1101,6 +1446,15 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	}
 
+	// Write-only password takes precedence, as it is mutually exclusive with password
+	password := plan.Password.ValueStringPointer()
+	if !config.PasswordWo.IsNull() {
+		password = config.PasswordWo.ValueStringPointer()
+	}
+	if password != nil && *password != "" {
+		ctx = tflog.MaskMessageStrings(ctx, *password)
+	}
+
 	// Rebuild operation
 	if !plan.SshKeys.Equal(state.SshKeys) || !plan.UserData.IsNull() && !plan.UserData.Equal(state.UserData) {
 		var rebuildReq *binarylane.PostServersServerIdActionsRebuildJSONRequestBody
@@ -1115,7 +1469,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Type: "rebuild",
 			Options: &binarylane.ImageOptions{
 				Name:     plan.Name.ValueStringPointer(),
-				Password: plan.Password.ValueStringPointer(),
+				Password: password,
 				UserData: plan.UserData.ValueStringPointer(),
 				SshKeys:  &sshKeys,
 			},
@@ -1138,13 +1492,15 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				fmt.Sprintf("Received %s rebuilding server: server_id=%s. Details: %s", rebuildResp.Status(), state.Id.String(), rebuildResp.Body))
 			return
 		}
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), rebuildResp.JSON200.Action.Id)
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), rebuildResp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for server to be rebuilt", err.Error())
 			return
 		}
+		powerStateCheckNeeded = true // Rebuilding will restart the server
 		state.Name = plan.Name
 		state.Password = plan.Password
+		state.PasswordWoVersion = plan.PasswordWoVersion
 		state.UserData = plan.UserData
 		state.SshKeys = plan.SshKeys
 
@@ -1155,23 +1511,30 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	} else
 	// Reset Password (only needed if server didn't rebuild)
-	if !plan.Password.Equal(state.Password) {
+	if !plan.Password.Equal(state.Password) || !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) {
 		passwordResp, err := r.bc.client.PostServersServerIdActionsPasswordResetWithResponse(ctx, state.Id.ValueInt64(),
 			binarylane.PasswordReset{
 				Type:     "password_reset",
-				Password: plan.Password.ValueStringPointer(),
+				Password: password,
 			},
 		)
 		if err != nil {
 			resp.Diagnostics.AddError("Error resetting password", err.Error())
 			return
 		}
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), passwordResp.JSON200.Action.Id)
+		if passwordResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Unexpected HTTP status code resetting password",
+				fmt.Sprintf("Received %s resetting password: server_id=%s. Details: %s", passwordResp.Status(), state.Id.String(), passwordResp.Body))
+			return
+		}
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), passwordResp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for password reset", err.Error())
 			return
 		}
 		state.Password = plan.Password
+		state.PasswordWoVersion = plan.PasswordWoVersion
 
 		// Save updated data into Terraform state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -1180,17 +1543,38 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	err := r.updateAdvancedFeatures(ctx, state.Id.ValueInt64(), &config.AdvancedFeatures, &state.AdvancedFeatures)
+	// Skip reboot for advanced features if the server will be rebooted, powered on or stopped later anyway
+	autoReboot := plan.AutoRebootOnFeatureChange.ValueBool() && !rebootNeeded && !poweringOn && plan.PowerState.ValueString() != serverPowerStateStopped
+	err := r.updateAdvancedFeatures(ctx, state.Id.ValueInt64(), &config.AdvancedFeatures, &state.AdvancedFeatures, autoReboot)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating advanced features", err.Error())
 		return
 	}
+	state.AutoRebootOnFeatureChange = plan.AutoRebootOnFeatureChange
 	resp.Diagnostics.Append(diag...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Change kernel
+	if !plan.KernelId.IsUnknown() && !plan.KernelId.IsNull() && !plan.KernelId.Equal(state.KernelId) {
+		err := r.updateKernel(ctx, state.Id.ValueInt64(), plan.KernelId.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error changing kernel", err.Error())
+			return
+		}
+		state.KernelId = plan.KernelId
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if plan.KernelId.IsUnknown() {
+		refreshNeeded = true
+	}
+
 	// Check source_and_destination_check
 	if !plan.SourceAndDestinationCheck.Equal(state.SourceAndDestinationCheck) {
 		if !plan.SourceAndDestinationCheck.IsNull() {
@@ -1239,7 +1623,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				resp.Diagnostics.AddError("Error enabling backups", err.Error())
 				return
 			}
-			err = r.waitForServerAction(ctx, state.Id.ValueInt64(), backupResp.JSON200.Action.Id)
+			err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), backupResp.JSON200.Action.Id)
 			if err != nil {
 				resp.Diagnostics.AddError("Error waiting for backups to be enabled", err.Error())
 				return
@@ -1252,7 +1636,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				resp.Diagnostics.AddError("Error disabling backups", err.Error())
 				return
 			}
-			err = r.waitForServerAction(ctx, state.Id.ValueInt64(), backupResp.JSON200.Action.Id)
+			err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), backupResp.JSON200.Action.Id)
 			if err != nil {
 				resp.Diagnostics.AddError("Error waiting for backups to be disabled", err.Error())
 				return
@@ -1279,7 +1663,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			resp.Diagnostics.AddError("Error changing \"port_blocking\" attribute", err.Error())
 			return
 		}
-		err = r.waitForServerAction(ctx, state.Id.ValueInt64(), portBlockingResp.JSON200.Action.Id)
+		err = r.bc.waitForServerAction(ctx, state.Id.ValueInt64(), portBlockingResp.JSON200.Action.Id)
 		if err != nil {
 			resp.Diagnostics.AddError("Error waiting for \"port_blocking\" attribute to change", err.Error())
 			return
@@ -1288,6 +1672,48 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		// Save updated data into Terraform state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Reboot if any of the reboot triggers have changed
+	if rebootNeeded {
+		err := r.rebootServer(ctx, state.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error rebooting server", err.Error())
+			return
+		}
+	}
+	state.RebootTriggers = plan.RebootTriggers
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Change power state, or restore it after a resize or rebuild
+	if powerStateCheckNeeded && !plan.PowerState.IsUnknown() && !plan.PowerState.IsNull() {
+		currentPowerState, err := r.readPowerState(ctx, state.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading power state", err.Error())
+			return
+		}
+		if currentPowerState != plan.PowerState.ValueString() {
+			err = r.updatePowerState(ctx, state.Id.ValueInt64(), plan.PowerState.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating power state", err.Error())
+				return
+			}
+		}
+		state.PowerState = plan.PowerState
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else if plan.PowerState.IsUnknown() {
+		state.PowerState = types.StringUnknown()
+		refreshNeeded = true
 	}
 }
 
@@ -1300,6 +1726,11 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	timeout, diags := data.Timeouts.Delete(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// Delete API call logic
 	tflog.Debug(ctx, fmt.Sprintf("Deleting server: name=%s", data.Id.String()))
 
@@ -1323,11 +1754,24 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			fmt.Sprintf("Received %s deleting server: name=%s, server_id=%s. Details: %s", serverResp.Status(), data.Name.ValueString(), data.Id.String(), serverResp.Body))
 		return
 	}
+
+	// Wait for server to be removed, so that dependent resources (VPCs, SSH keys) can be deleted
+	err = r.waitForServerDeletion(ctx, data.Id.ValueInt64(), data.VpcId.ValueInt64Pointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for server to be deleted", err.Error())
+		return
+	}
 }
 
 func (r *serverResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	// Import by identity
+	if req.ID == "" {
+		importStateByIdentity(ctx, req, resp)
+		return
+	}
+
 	// Import by ID
 	id, err := strconv.ParseInt(req.ID, 10, 32)
 	if err == nil {
@@ -1372,28 +1816,43 @@ func (r *serverResource) ImportState(
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *serverResource) waitForServerAction(ctx context.Context, serverId int64, actionId int64) error {
+func (bc *BinarylaneClient) waitForServerAction(ctx context.Context, serverId int64, actionId int64) error {
+	_, err := bc.waitForServerActionProgress(ctx, serverId, actionId, nil)
+	return err
+}
+
+// waitForServerActionProgress waits for a server action to complete, calling onProgress (if not nil) with each
+// response received while the action is in progress, and returns the completed action.
+func (bc *BinarylaneClient) waitForServerActionProgress(
+	ctx context.Context,
+	serverId int64,
+	actionId int64,
+	onProgress func(action *binarylane.Action),
+) (*binarylane.Action, error) {
 	var lastReadyResp *binarylane.GetServersServerIdActionsActionIdResponse
 
 	for {
 		select {
 		case <-ctx.Done():
 			if lastReadyResp == nil {
-				return fmt.Errorf("timed out waiting for server action: server_id=%d, action_id=%d", serverId, actionId)
+				return nil, fmt.Errorf("timed out waiting for server action: server_id=%d, action_id=%d", serverId, actionId)
 			} else {
-				return fmt.Errorf("timed out waiting for server action: server_id=%d, action_id=%d, last response was status=%s, body: %s",
+				return nil, fmt.Errorf("timed out waiting for server action: server_id=%d, action_id=%d, last response was status=%s, body: %s",
 					serverId, actionId, lastReadyResp.Status(), lastReadyResp.Body)
 			}
 		default:
-			readyResp, err := r.bc.client.GetServersServerIdActionsActionIdWithResponse(ctx, serverId, actionId)
+			readyResp, err := bc.client.GetServersServerIdActionsActionIdWithResponse(ctx, serverId, actionId)
 			if err != nil {
-				return fmt.Errorf("unexpected error waiting for server action: server_id=%d, action_id=%d, error: %w", serverId, actionId, err)
+				return nil, fmt.Errorf("unexpected error waiting for server action: server_id=%d, action_id=%d, error: %w", serverId, actionId, err)
 			}
 			if readyResp.StatusCode() == http.StatusOK && readyResp.JSON200.Action.Status == binarylane.Errored {
-				return fmt.Errorf("server action failed to with error: server_id=%d, action_id=%d, error: %s", serverId, actionId, readyResp.Body)
+				return nil, fmt.Errorf("server action failed to with error: server_id=%d, action_id=%d, error: %s", serverId, actionId, readyResp.Body)
 			}
 			if readyResp.StatusCode() == http.StatusOK && readyResp.JSON200.Action.CompletedAt != nil {
-				return nil
+				return &readyResp.JSON200.Action, nil
+			}
+			if readyResp.StatusCode() == http.StatusOK && onProgress != nil {
+				onProgress(&readyResp.JSON200.Action)
 			}
 			lastReadyResp = readyResp
 			tflog.Debug(ctx,
@@ -1406,6 +1865,247 @@ func (r *serverResource) waitForServerAction(ctx context.Context, serverId int64
 	}
 }
 
+func (r *serverResource) waitForServerDeletion(ctx context.Context, serverId int64, vpcId *int64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for server to be deleted: server_id=%d", serverId)
+		default:
+			serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, serverId)
+			if err != nil {
+				return fmt.Errorf("unexpected error waiting for server to be deleted: server_id=%d, error: %w", serverId, err)
+			}
+			if serverResp.StatusCode() == http.StatusNotFound {
+				return nil
+			}
+
+			// The server record can outlive the deletion request, so also check whether it has left its VPC
+			if vpcId != nil {
+				isMember, err := r.isVpcMember(ctx, *vpcId, serverId)
+				if err != nil {
+					return err
+				}
+				if !isMember {
+					return nil
+				}
+			}
+
+			tflog.Debug(ctx,
+				fmt.Sprintf("waiting for server to be deleted for server_id=%d: last response was status=%s, details: %s",
+					serverId, serverResp.Status(), serverResp.Body,
+				),
+			)
+		}
+		time.Sleep(time.Second * 5)
+	}
+}
+
+func (r *serverResource) isVpcMember(ctx context.Context, vpcId int64, serverId int64) (bool, error) {
+	var page int32 = 1
+	perPage := int32(200)
+	resourceType := binarylane.ResourceTypeServer
+	resourceId := strconv.FormatInt(serverId, 10)
+
+	for {
+		params := binarylane.GetVpcsVpcIdMembersParams{
+			ResourceType: &resourceType,
+			Page:         &page,
+			PerPage:      &perPage,
+		}
+
+		membersResp, err := r.bc.client.GetVpcsVpcIdMembersWithResponse(ctx, vpcId, &params)
+		if err != nil {
+			return false, fmt.Errorf("error reading VPC members: vpc_id=%d, error: %w", vpcId, err)
+		}
+		if membersResp.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		if membersResp.StatusCode() != http.StatusOK {
+			return false, fmt.Errorf("unexpected HTTP status code reading VPC members: vpc_id=%d, status=%s, details: %s",
+				vpcId, membersResp.Status(), membersResp.Body)
+		}
+
+		for _, member := range membersResp.JSON200.Members {
+			if member.ResourceType == binarylane.ResourceTypeServer && member.ResourceId == resourceId {
+				return true, nil
+			}
+		}
+
+		if membersResp.JSON200.Links == nil || membersResp.JSON200.Links.Pages.Next == nil {
+			return false, nil
+		}
+		page++
+	}
+}
+
+// serverPowerState maps the status of a server to the values of the "power_state" attribute. A new server is still
+// being built and is started once it is ready, and an archived server is powered off. Any other status is not a
+// valid power state, so it is null.
+func serverPowerState(status binarylane.ServerStatus) types.String {
+	switch status {
+	case binarylane.Active, binarylane.New:
+		return types.StringValue(serverPowerStateRunning)
+	case binarylane.Off, binarylane.Archive:
+		return types.StringValue(serverPowerStateStopped)
+	default:
+		return types.StringNull()
+	}
+}
+
+func serverKernelId(kernel *binarylane.Kernel) types.Int64 {
+	if kernel == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(kernel.Id)
+}
+
+func (r *serverResource) updateKernel(ctx context.Context, serverId int64, kernelId int64) error {
+	tflog.Info(ctx, fmt.Sprintf("Changing kernel for server: server_id=%d, kernel_id=%d", serverId, kernelId))
+
+	kernelResp, err := r.bc.client.PostServersServerIdActionsChangeKernelWithResponse(
+		ctx,
+		serverId,
+		binarylane.PostServersServerIdActionsChangeKernelJSONRequestBody{
+			Type:   "change_kernel",
+			Kernel: kernelId,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error changing kernel for server: server_id=%d, error: %w", serverId, err)
+	}
+	if kernelResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code changing kernel for server: server_id=%d, details: %s", serverId, kernelResp.Body)
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, kernelResp.JSON200.Action.Id)
+	if err != nil {
+		return fmt.Errorf("error changing kernel: %w", err)
+	}
+
+	return nil
+}
+
+func (r *serverResource) readPowerState(ctx context.Context, serverId int64) (string, error) {
+	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, serverId)
+	if err != nil {
+		return "", fmt.Errorf("error reading server: server_id=%d, error: %w", serverId, err)
+	}
+	if serverResp.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("unexpected HTTP status code reading server: server_id=%d, details: %s", serverId, serverResp.Body)
+	}
+
+	return serverPowerState(serverResp.JSON200.Server.Status).ValueString(), nil
+}
+
+func (r *serverResource) updatePowerState(ctx context.Context, serverId int64, powerState string) error {
+	tflog.Info(ctx, fmt.Sprintf("Changing power state for server: server_id=%d, power_state=%s", serverId, powerState))
+
+	if powerState == serverPowerStateRunning {
+		powerOnResp, err := r.bc.client.PostServersServerIdActionsPowerOnWithResponse(ctx, serverId, binarylane.PowerOn{
+			Type: "power_on",
+		})
+		if err != nil {
+			return fmt.Errorf("error powering on server: server_id=%d, error: %w", serverId, err)
+		}
+		if powerOnResp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("unexpected HTTP status code powering on server: server_id=%d, details: %s", serverId, powerOnResp.Body)
+		}
+
+		err = r.bc.waitForServerAction(ctx, serverId, powerOnResp.JSON200.Action.Id)
+		if err != nil {
+			return fmt.Errorf("error powering on server: %w", err)
+		}
+
+		return nil
+	}
+
+	// Attempt a graceful shutdown first
+	shutdownResp, err := r.bc.client.PostServersServerIdActionsShutdownWithResponse(ctx, serverId, binarylane.Shutdown{
+		Type: "shutdown",
+	})
+	if err != nil {
+		return fmt.Errorf("error shutting down server: server_id=%d, error: %w", serverId, err)
+	}
+	if shutdownResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code shutting down server: server_id=%d, details: %s", serverId, shutdownResp.Body)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, serverShutdownTimeout)
+	defer cancel()
+
+	err = r.bc.waitForServerAction(shutdownCtx, serverId, shutdownResp.JSON200.Action.Id)
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("error shutting down server: %w", err)
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Server did not shut down gracefully, powering off: server_id=%d, error: %s", serverId, err))
+
+	// Fall back to powering off the server
+	powerOffResp, err := r.bc.client.PostServersServerIdActionsPowerOffWithResponse(ctx, serverId, binarylane.PowerOff{
+		Type: "power_off",
+	})
+	if err != nil {
+		return fmt.Errorf("error powering off server: server_id=%d, error: %w", serverId, err)
+	}
+	if powerOffResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code powering off server: server_id=%d, details: %s", serverId, powerOffResp.Body)
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, powerOffResp.JSON200.Action.Id)
+	if err != nil {
+		return fmt.Errorf("error powering off server: %w", err)
+	}
+
+	return nil
+}
+
+func (r *serverResource) rebootServer(ctx context.Context, serverId int64) error {
+	tflog.Info(ctx, fmt.Sprintf("Rebooting server: server_id=%d", serverId))
+
+	// Attempt a graceful reboot first
+	rebootResp, err := r.bc.client.PostServersServerIdActionsRebootWithResponse(ctx, serverId, binarylane.Reboot{
+		Type: "reboot",
+	})
+	if err != nil {
+		return fmt.Errorf("error rebooting server: server_id=%d, error: %w", serverId, err)
+	}
+	if rebootResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code rebooting server: server_id=%d, details: %s", serverId, rebootResp.Body)
+	}
+
+	rebootCtx, cancel := context.WithTimeout(ctx, serverShutdownTimeout)
+	defer cancel()
+
+	err = r.bc.waitForServerAction(rebootCtx, serverId, rebootResp.JSON200.Action.Id)
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("error rebooting server: %w", err)
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Server did not reboot gracefully, power cycling: server_id=%d, error: %s", serverId, err))
+
+	// Fall back to power cycling the server
+	powerCycleResp, err := r.bc.client.PostServersServerIdActionsPowerCycleWithResponse(ctx, serverId, binarylane.PowerCycle{
+		Type: "power_cycle",
+	})
+	if err != nil {
+		return fmt.Errorf("error power cycling server: server_id=%d, error: %w", serverId, err)
+	}
+	if powerCycleResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status code power cycling server: server_id=%d, details: %s", serverId, powerCycleResp.Body)
+	}
+
+	err = r.bc.waitForServerAction(ctx, serverId, powerCycleResp.JSON200.Action.Id)
+	if err != nil {
+		return fmt.Errorf("error power cycling server: %w", err)
+	}
+
+	return nil
+}
+
 func attrsRequiringRebuild(plan *serverResourceModel, state *serverResourceModel) []string {
 	attrs := []string{}
 
@@ -1445,7 +2145,7 @@ func (r *serverResource) updateSourceDestCheck(
 		return fmt.Errorf("unexpected HTTP status code changing source and destination check for server: server_id=%d, details: %s", serverId, sourceDestCheckResp.Body)
 	}
 
-	err = r.waitForServerAction(ctx, serverId, sourceDestCheckResp.JSON200.Action.Id)
+	err = r.bc.waitForServerAction(ctx, serverId, sourceDestCheckResp.JSON200.Action.Id)
 	if err != nil {
 		return fmt.Errorf("error changing source and destination check: %w", err)
 	}
@@ -1476,7 +2176,7 @@ func (r *serverResource) updateSeparatePrivateNetworkInterface(
 		return fmt.Errorf("unexpected HTTP status code changing separate private network interface for server: server_id=%d, details: %s", serverId, separatePrivateNicResp.Body)
 	}
 
-	err = r.waitForServerAction(ctx, serverId, separatePrivateNicResp.JSON200.Action.Id)
+	err = r.bc.waitForServerAction(ctx, serverId, separatePrivateNicResp.JSON200.Action.Id)
 	if err != nil {
 		return fmt.Errorf("error changing separate private network interface: %w", err)
 	}
@@ -1485,8 +2185,6 @@ func (r *serverResource) updateSeparatePrivateNetworkInterface(
 }
 
 func (r *serverResource) fetchServerResourceState(ctx context.Context, state *serverResourceModel) diag.Diagnostics {
-	var diags diag.Diagnostics
-
 	serverResp, err := r.bc.client.GetServersServerIdWithResponse(ctx, state.Id.ValueInt64())
 	if err != nil {
 		return diag.Diagnostics{
@@ -1505,27 +2203,38 @@ func (r *serverResource) fetchServerResourceState(ctx context.Context, state *se
 		}
 	}
 
-	state.Id = types.Int64Value(serverResp.JSON200.Server.Id)
-	state.Name = types.StringValue(serverResp.JSON200.Server.Name)
-	state.Image = types.StringValue(*serverResp.JSON200.Server.Image.Slug)
-	state.Region = types.StringValue(serverResp.JSON200.Server.Region.Slug)
-	state.Size = types.StringValue(serverResp.JSON200.Server.Size.Slug)
-	state.Backups = types.BoolValue(serverResp.JSON200.Server.NextBackupWindow != nil)
-	state.PortBlocking = types.BoolValue(serverResp.JSON200.Server.Networks.PortBlocking)
-	state.VpcId = types.Int64PointerValue(serverResp.JSON200.Server.VpcId)
-	state.Permalink = types.StringValue(*serverResp.JSON200.Server.Permalink)
-	state.PasswordChangeSupported = types.BoolValue(serverResp.JSON200.Server.PasswordChangeSupported)
-	state.SourceAndDestinationCheck = types.BoolPointerValue(serverResp.JSON200.Server.Networks.SourceAndDestinationCheck)
-	state.SeparatePrivateNetworkInterface = types.BoolPointerValue(serverResp.JSON200.Server.Networks.SeparatePrivateNetworkInterface)
-	state.Memory = types.Int32Value(serverResp.JSON200.Server.Memory)
-	state.Disk = types.Int32Value(serverResp.JSON200.Server.Disk)
-	state.Backups = types.BoolValue(serverResp.JSON200.Server.NextBackupWindow != nil)
-	state.Ipv6 = types.BoolValue(len(serverResp.JSON200.Server.Networks.V6) > 0)
+	return setServerResourceState(ctx, state, &serverResp.JSON200.Server)
+}
 
-	if serverResp.JSON200.Server.VpcId == nil {
+// setServerResourceState sets the attributes of the server resource model that are returned by the API.
+func setServerResourceState(ctx context.Context, state *serverResourceModel, server *binarylane.Server) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Id = types.Int64Value(server.Id)
+	state.Name = types.StringValue(server.Name)
+	state.Image = types.StringValue(*server.Image.Slug)
+	state.Region = types.StringValue(server.Region.Slug)
+	state.Size = types.StringValue(server.Size.Slug)
+	state.Backups = types.BoolValue(server.NextBackupWindow != nil)
+	state.PortBlocking = types.BoolValue(server.Networks.PortBlocking)
+	state.VpcId = types.Int64PointerValue(server.VpcId)
+	state.Permalink = types.StringValue(*server.Permalink)
+	state.PasswordChangeSupported = types.BoolValue(server.PasswordChangeSupported)
+	state.SourceAndDestinationCheck = types.BoolPointerValue(server.Networks.SourceAndDestinationCheck)
+	state.SeparatePrivateNetworkInterface = types.BoolPointerValue(server.Networks.SeparatePrivateNetworkInterface)
+	state.Memory = types.Int32Value(server.Memory)
+	state.Disk = types.Int32Value(server.Disk)
+	state.Backups = types.BoolValue(server.NextBackupWindow != nil)
+	state.Ipv6 = types.BoolValue(len(server.Networks.V6) > 0)
+	state.PowerState = serverPowerState(server.Status)
+	state.KernelId = serverKernelId(server.Kernel)
+	state.PartnerId = types.Int64PointerValue(server.PartnerId)
+	state.setSelectedSizeOptions(server.SelectedSizeOptions)
+
+	if server.VpcId == nil {
 		state.VpcIpv4Address = types.StringNull()
-	} else if len(serverResp.JSON200.Server.Networks.V4) > 0 {
-		for _, v4address := range serverResp.JSON200.Server.Networks.V4 {
+	} else if len(server.Networks.V4) > 0 {
+		for _, v4address := range server.Networks.V4 {
 			// Skip addresses in 172.21.0.0/16, these are BL internal addresses that are not part of the user's VPC
 			if v4address.Type == "private" && !strings.HasPrefix(v4address.IpAddress, "172.21.") {
 				state.VpcIpv4Address = types.StringValue(v4address.IpAddress)
@@ -1534,7 +2243,7 @@ func (r *serverResource) fetchServerResourceState(ctx context.Context, state *se
 		}
 	}
 
-	advFeat := serverResp.JSON200.Server.AdvancedFeatures.EnabledAdvancedFeatures
+	advFeat := server.AdvancedFeatures.EnabledAdvancedFeatures
 	state.AdvancedFeatures, diags = resources.NewAdvancedFeaturesValue(
 		resources.AdvancedFeaturesValue{}.AttributeTypes(ctx),
 		map[string]attr.Value{
@@ -1556,7 +2265,7 @@ func (r *serverResource) fetchServerResourceState(ctx context.Context, state *se
 	publicIpv4Addresses := []string{}
 	privateIpv4Addresses := []string{}
 
-	for _, v4address := range serverResp.JSON200.Server.Networks.V4 {
+	for _, v4address := range server.Networks.V4 {
 		switch v4address.Type {
 		case "public":
 			publicIpv4Addresses = append(publicIpv4Addresses, v4address.IpAddress)
@@ -1586,7 +2295,7 @@ func (r *serverResource) fetchServerResourceState(ctx context.Context, state *se
 	publicIpv6Addresses := []string{}
 	privateIpv6Addresses := []string{}
 
-	for _, v6address := range serverResp.JSON200.Server.Networks.V6 {
+	for _, v6address := range server.Networks.V6 {
 		switch v6address.Type {
 		case "public":
 			publicIpv6Addresses = append(publicIpv6Addresses, v6address.IpAddress)
@@ -1611,9 +2320,33 @@ func (r *serverResource) fetchServerResourceState(ctx context.Context, state *se
 		state.PrivateIpv6Addresses = tfPrivateIpv6Addresses
 	}
 
+	tfFailoverIps, diag := types.ListValueFrom(ctx, types.StringType, serverFailoverIps(server.FailoverIps))
+	diags.Append(diag...)
+	if diag.HasError() {
+		state.FailoverIps = types.ListUnknown(state.FailoverIps.ElementType(ctx))
+	} else {
+		state.FailoverIps = tfFailoverIps
+	}
+
 	return diags
 }
 
+func serverFailoverIps(failoverIps []string) []string {
+	if failoverIps == nil {
+		return []string{}
+	}
+	return failoverIps
+}
+
+func isSizeOptionsChanged(plan *serverDataModel, state *serverDataModel) bool {
+	// Check if any of the size options that do not require a change of size have been modified by the user
+	return (!plan.DailyBackups.IsNull() && !plan.DailyBackups.IsUnknown() && !plan.DailyBackups.Equal(state.DailyBackups)) ||
+		(!plan.WeeklyBackups.IsNull() && !plan.WeeklyBackups.IsUnknown() && !plan.WeeklyBackups.Equal(state.WeeklyBackups)) ||
+		(!plan.MonthlyBackups.IsNull() && !plan.MonthlyBackups.IsUnknown() && !plan.MonthlyBackups.Equal(state.MonthlyBackups)) ||
+		(!plan.OffsiteBackups.IsNull() && !plan.OffsiteBackups.IsUnknown() && !plan.OffsiteBackups.Equal(state.OffsiteBackups)) ||
+		(!plan.Transfer.IsNull() && !plan.Transfer.IsUnknown() && !plan.Transfer.Equal(state.Transfer))
+}
+
 func isAdvFeatChanged(config *resources.AdvancedFeaturesValue, data *resources.AdvancedFeaturesValue) bool {
 	// Check if any of the writable advanced features have been modified by the user
 	return (!config.EmulatedHyperv.IsNull() && !config.EmulatedHyperv.Equal(data.EmulatedHyperv)) ||
@@ -1630,6 +2363,7 @@ func (r *serverResource) updateAdvancedFeatures(
 	serverId int64,
 	config *resources.AdvancedFeaturesValue,
 	data *resources.AdvancedFeaturesValue,
+	autoReboot bool,
 ) error {
 	// If none of the writable advanced features have been modified by the user, we can skip the update
 	if !isAdvFeatChanged(config, data) {
@@ -1699,11 +2433,19 @@ func (r *serverResource) updateAdvancedFeatures(
 		return fmt.Errorf("unexpected HTTP status code updating advanced features for server: server_id=%d, details: %s", serverId, resp.Body)
 	}
 
-	err = r.waitForServerAction(ctx, serverId, resp.JSON200.Action.Id)
+	err = r.bc.waitForServerAction(ctx, serverId, resp.JSON200.Action.Id)
 	if err != nil {
 		return fmt.Errorf("failed to confirm advanced features for server was successful: %w", err)
 	}
 
+	// Advanced features only take effect after the server is rebooted
+	if autoReboot {
+		err = r.rebootServer(ctx, serverId)
+		if err != nil {
+			return fmt.Errorf("failed to reboot server after changing advanced features: %w", err)
+		}
+	}
+
 	data.EmulatedHyperv = types.BoolValue(emulatedHyperV)
 	data.EmulatedDevices = types.BoolValue(emulatedDevices)
 	data.EmulatedTpm = types.BoolValue(emulatedTPM)
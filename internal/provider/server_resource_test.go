@@ -12,7 +12,10 @@ import (
 	"terraform-provider-binarylane/internal/binarylane"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestServerResource(t *testing.T) {
@@ -72,8 +75,17 @@ data "binarylane_server" "test" {
 
   id = binarylane_server.test.id
 }
+
+data "binarylane_server" "by_name" {
+  depends_on = [binarylane_server.test]
+
+  name = "tf-test-server-resource"
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify lookup by name
+					resource.TestCheckResourceAttrPair("data.binarylane_server.by_name", "id", "binarylane_server.test", "id"),
+
 					// Verify resource values
 					resource.TestCheckResourceAttrSet("binarylane_server.test", "id"),
 					resource.TestCheckResourceAttr("binarylane_server.test", "name", "tf-test-server-resource"),
@@ -110,6 +122,7 @@ echo "Hello World" > /var/tmp/output.txt
 					resource.TestCheckResourceAttr("binarylane_server.test", "advanced_features.uefi_boot", "false"),
 					resource.TestCheckResourceAttr("binarylane_server.test", "ipv6", "false"),
 					resource.TestCheckResourceAttr("binarylane_server.test", "public_ipv6_addresses.#", "0"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "running"),
 
 					// Verify data source values
 					resource.TestCheckResourceAttrPair("data.binarylane_server.test", "id", "binarylane_server.test", "id"),
@@ -141,6 +154,7 @@ echo "Hello World" > /var/tmp/output.txt
 					resource.TestCheckResourceAttr("data.binarylane_server.test", "advanced_features.uefi_boot", "false"),
 					resource.TestCheckResourceAttr("data.binarylane_server.test", "ipv6", "false"),
 					resource.TestCheckResourceAttr("data.binarylane_server.test", "public_ipv6_addresses.#", "0"),
+					resource.TestCheckResourceAttr("data.binarylane_server.test", "power_state", "running"),
 				),
 			},
 			// Test import by ID
@@ -329,6 +343,261 @@ resource "binarylane_server" "test" {
 	})
 }
 
+func TestServerResourcePowerState(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create powered off
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-power-state"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	power_state       = "stopped"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "stopped"),
+				),
+			},
+			// Power on
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-power-state"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	power_state       = "running"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "running"),
+				),
+			},
+			// Resize should leave the server powered off
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-power-state"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	disk              = 25
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	power_state       = "stopped"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "disk", "25"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerResourceSizeOptions(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with default size options
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-size-options"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "daily_backups"),
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "weekly_backups"),
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "monthly_backups"),
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "offsite_backups"),
+					resource.TestCheckResourceAttrSet("binarylane_server.test", "transfer"),
+				),
+			},
+			// Change size options without changing size
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-size-options"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	backups           = true
+	daily_backups     = 3
+	weekly_backups    = 1
+	monthly_backups   = 1
+	offsite_backups   = true
+	transfer          = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "size", "std-min"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "daily_backups", "3"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "weekly_backups", "1"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "monthly_backups", "1"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "offsite_backups", "true"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "transfer", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerResourcePasswordWriteOnly(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+	newPassword := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with write-only password
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name                = "tf-test-server-password-wo"
+	region              = "per"
+	image               = "debian-11"
+	size                = "std-min"
+	public_ipv4_count   = 0
+	password_wo         = "` + password + `"
+	password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("binarylane_server.test", "password"),
+					resource.TestCheckNoResourceAttr("binarylane_server.test", "password_wo"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "password_wo_version", "1"),
+				),
+			},
+			// Changing the version resets the password
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name                = "tf-test-server-password-wo"
+	region              = "per"
+	image               = "debian-11"
+	size                = "std-min"
+	public_ipv4_count   = 0
+	password_wo         = "` + newPassword + `"
+	password_wo_version = 2
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("binarylane_server.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("binarylane_server.test", "password_wo"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerResourceReboot(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Setup
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-reboot"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	reboot_triggers   = {
+		config = "1"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "reboot_triggers.config", "1"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "auto_reboot_on_feature_change", "false"),
+				),
+			},
+			// Reboot by changing trigger
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name              = "tf-test-server-reboot"
+	region            = "per"
+	image             = "debian-11"
+	size              = "std-min"
+	public_ipv4_count = 0
+	password          = "` + password + `"
+	reboot_triggers   = {
+		config = "2"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "reboot_triggers.config", "2"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "running"),
+				),
+			},
+			// Reboot after changing advanced features
+			{
+				Config: providerConfig + `
+resource "binarylane_server" "test" {
+	name                          = "tf-test-server-reboot"
+	region                        = "per"
+	image                         = "debian-11"
+	size                          = "std-min"
+	public_ipv4_count             = 0
+	password                      = "` + password + `"
+	auto_reboot_on_feature_change = true
+	advanced_features = {
+		local_rtc = true
+	}
+	reboot_triggers   = {
+		config = "2"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_server.test", "auto_reboot_on_feature_change", "true"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "advanced_features.local_rtc", "true"),
+					resource.TestCheckResourceAttr("binarylane_server.test", "power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
 func TestServerVpcIpv4Change(t *testing.T) {
 	// Must assign a password to the server or Binary Lane will send emails
 	password := GenerateTestPassword(t)
@@ -476,6 +745,22 @@ data "binarylane_server" "test" {
 	})
 }
 
+func TestServerPowerState(t *testing.T) {
+	testCases := map[binarylane.ServerStatus]types.String{
+		binarylane.Active:                  types.StringValue(serverPowerStateRunning),
+		binarylane.New:                     types.StringValue(serverPowerStateRunning),
+		binarylane.Off:                     types.StringValue(serverPowerStateStopped),
+		binarylane.Archive:                 types.StringValue(serverPowerStateStopped),
+		binarylane.ServerStatus("unknown"): types.StringNull(),
+	}
+
+	for status, expected := range testCases {
+		if actual := serverPowerState(status); !actual.Equal(expected) {
+			t.Errorf("serverPowerState(%q): expected %s, got: %s", status, expected, actual)
+		}
+	}
+}
+
 func GenerateTestPassword(t *testing.T) string {
 	t.Helper()
 	pwBytes := make([]byte, 12)
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &validServerSizeFunction{}
+	_ function.Function = &roundServerSizeFunction{}
+)
+
+// serverSizeRules describes the valid values of a customisable server size option, such as memory or disk.
+type serverSizeRules struct {
+	// The name of the option, used as the function name suffix and the parameter name
+	name string
+	// The unit of the option, e.g. "MB"
+	unit      string
+	minimum   int64
+	multiples []MultipleOfValidator
+}
+
+var (
+	serverMemorySizeRules = serverSizeRules{
+		name:      "memory",
+		unit:      "MB",
+		minimum:   serverMemoryMinimum,
+		multiples: serverMemoryMultiples,
+	}
+	serverDiskSizeRules = serverSizeRules{
+		name:      "disk",
+		unit:      "GB",
+		minimum:   serverDiskMinimum,
+		multiples: serverDiskMultiples,
+	}
+)
+
+func (r serverSizeRules) isValid(value int64) bool {
+	if value < r.minimum {
+		return false
+	}
+	for _, v := range r.multiples {
+		if !v.isValid(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// roundUp returns the smallest valid value that is greater than or equal to value.
+func (r serverSizeRules) roundUp(value float64) int64 {
+	rounded := max(int64(math.Ceil(value)), r.minimum)
+	for {
+		next := rounded
+		for _, v := range r.multiples {
+			if !v.isValid(next) {
+				next = (next/int64(v.Multiple) + 1) * int64(v.Multiple)
+			}
+		}
+		if next == rounded {
+			return rounded
+		}
+		rounded = next
+	}
+}
+
+// serverSizeUnits are the units accepted in a size string, where each unit is 1024 times the previous unit.
+var serverSizeUnits = []string{"KB", "MB", "GB", "TB"}
+
+var serverSizePattern = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([A-Za-z]*)\s*$`)
+
+// parse returns the amount of a size argument in the unit of the option. The argument is either a number in the unit
+// of the option, or a string with an optional unit, e.g. "2.5 GB". Units are binary, so 1 GB is 1024 MB.
+func (r serverSizeRules) parse(arg types.Dynamic) (float64, *function.FuncError) {
+	switch value := arg.UnderlyingValue().(type) {
+	case types.Number:
+		f, _ := value.ValueBigFloat().Float64()
+		return f, nil
+	case types.String:
+		matches := serverSizePattern.FindStringSubmatch(value.ValueString())
+		if matches == nil {
+			return 0, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a size, e.g. \"2.5 GB\"", value.ValueString()))
+		}
+		amount, err := strconv.ParseFloat(matches[1], 64)
+		if err != nil {
+			return 0, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a size: %s", value.ValueString(), err))
+		}
+		if matches[2] == "" {
+			return amount, nil
+		}
+		unit := strings.Replace(strings.ToUpper(matches[2]), "IB", "B", 1)
+		from := slices.Index(serverSizeUnits, unit)
+		if from < 0 {
+			return 0, function.NewArgumentFuncError(0, fmt.Sprintf("%q does not have a valid unit, must be one of: %s",
+				value.ValueString(), strings.Join(serverSizeUnits, ", ")))
+		}
+		to := slices.Index(serverSizeUnits, r.unit)
+		return amount * math.Pow(1024, float64(from-to)), nil
+	default:
+		return 0, function.NewArgumentFuncError(0, fmt.Sprintf("%s must be a number or a string", r.name))
+	}
+}
+
+func (r serverSizeRules) parameter() function.Parameter {
+	return function.DynamicParameter{
+		Name: r.name,
+		MarkdownDescription: fmt.Sprintf("The amount of %s, either as a number in %s, or as a string with a unit, e.g. "+
+			"`\"2.5 GB\"`. Units are binary, so `1 GB` is `1024 MB`.", r.name, r.unit),
+	}
+}
+
+func (r serverSizeRules) markdownRules() string {
+	rules := fmt.Sprintf("  - must be at least %d\n", r.minimum)
+	for _, v := range r.multiples {
+		if v.RangeFrom == 0 {
+			rules += fmt.Sprintf("  - must be a multiple of %d\n", v.Multiple)
+		} else {
+			rules += fmt.Sprintf("  - \\> %d %s must be a multiple of %d\n", v.RangeFrom, r.unit, v.Multiple)
+		}
+	}
+	return rules
+}
+
+func NewValidMemoryFunction() function.Function {
+	return &validServerSizeFunction{rules: serverMemorySizeRules}
+}
+
+func NewValidDiskFunction() function.Function {
+	return &validServerSizeFunction{rules: serverDiskSizeRules}
+}
+
+type validServerSizeFunction struct {
+	rules serverSizeRules
+}
+
+func (f *validServerSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_" + f.rules.name
+}
+
+func (f *validServerSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: fmt.Sprintf("Check whether a value is a valid amount of %s for a server", f.rules.name),
+		MarkdownDescription: fmt.Sprintf("Returns `true` if the value is a valid `%s` for `binarylane_server`, otherwise `false`. "+
+			"Valid values:\n%s", f.rules.name, f.rules.markdownRules()),
+		Parameters: []function.Parameter{
+			f.rules.parameter(),
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *validServerSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := f.rules.parse(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	valid := value == math.Trunc(value) && f.rules.isValid(int64(value))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}
+
+func NewRoundMemoryFunction() function.Function {
+	return &roundServerSizeFunction{rules: serverMemorySizeRules}
+}
+
+func NewRoundDiskFunction() function.Function {
+	return &roundServerSizeFunction{rules: serverDiskSizeRules}
+}
+
+type roundServerSizeFunction struct {
+	rules serverSizeRules
+}
+
+func (f *roundServerSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "round_" + f.rules.name
+}
+
+func (f *roundServerSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: fmt.Sprintf("Round a value up to a valid amount of %s for a server", f.rules.name),
+		MarkdownDescription: fmt.Sprintf("Returns the smallest valid `%s` for `binarylane_server` that is greater than "+
+			"or equal to the value. Fractional values are accepted, so that the result can be computed from other units "+
+			"(e.g. `\"2.5 GB\"` or `2.5 * 1024`). Valid values:\n%s", f.rules.name, f.rules.markdownRules()),
+		Parameters: []function.Parameter{
+			f.rules.parameter(),
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *roundServerSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := f.rules.parse(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, f.rules.roundUp(value)))
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestServerSizeFunctions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+output "valid_memory" {
+	value = provider::binarylane::valid_memory(3072)
+}
+
+output "invalid_memory" {
+	value = provider::binarylane::valid_memory(2560)
+}
+
+output "round_memory" {
+	value = provider::binarylane::round_memory(2.5 * 1024)
+}
+
+output "round_memory_string" {
+	value = provider::binarylane::round_memory("2.5 GB")
+}
+
+output "valid_memory_string" {
+	value = provider::binarylane::valid_memory("3GiB")
+}
+
+output "round_memory_minimum" {
+	value = provider::binarylane::round_memory(0)
+}
+
+output "valid_disk" {
+	value = provider::binarylane::valid_disk(70)
+}
+
+output "invalid_disk" {
+	value = provider::binarylane::valid_disk(65)
+}
+
+output "round_disk" {
+	value = provider::binarylane::round_disk(201)
+}
+
+output "round_disk_string" {
+	value = provider::binarylane::round_disk("0.25 TB")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("valid_memory", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("invalid_memory", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("round_memory", knownvalue.Int64Exact(3072)),
+					statecheck.ExpectKnownOutputValue("round_memory_string", knownvalue.Int64Exact(3072)),
+					statecheck.ExpectKnownOutputValue("valid_memory_string", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("round_memory_minimum", knownvalue.Int64Exact(128)),
+					statecheck.ExpectKnownOutputValue("valid_disk", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("invalid_disk", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("round_disk", knownvalue.Int64Exact(300)),
+					statecheck.ExpectKnownOutputValue("round_disk_string", knownvalue.Int64Exact(300)),
+				},
+			},
+		},
+	})
+}
+
+func TestServerSizeRulesParse(t *testing.T) {
+	testCases := map[string]float64{
+		"3072":       3072,
+		"2.5 GB":     2560,
+		"2.5gb":      2560,
+		"3 GiB":      3072,
+		"512 MB":     512,
+		"1 TB":       1024 * 1024,
+		"1048576 KB": 1024,
+	}
+
+	for value, expected := range testCases {
+		actual, err := serverMemorySizeRules.parse(types.DynamicValue(types.StringValue(value)))
+		if err != nil {
+			t.Errorf("parse(%q): unexpected error: %s", value, err)
+		} else if actual != expected {
+			t.Errorf("parse(%q): expected %v, got: %v", value, expected, actual)
+		}
+	}
+
+	actual, err := serverDiskSizeRules.parse(types.DynamicValue(types.StringValue("2048 MB")))
+	if err != nil || actual != 2 {
+		t.Errorf("parse(%q): expected 2, got: %v, %v", "2048 MB", actual, err)
+	}
+
+	actual, err = serverMemorySizeRules.parse(types.DynamicValue(types.NumberValue(big.NewFloat(1536))))
+	if err != nil || actual != 1536 {
+		t.Errorf("parse(1536): expected 1536, got: %v, %v", actual, err)
+	}
+
+	for _, value := range []string{"", "GB", "2.5 PB", "-1 GB", "1,024 MB"} {
+		if _, err := serverMemorySizeRules.parse(types.DynamicValue(types.StringValue(value))); err == nil {
+			t.Errorf("parse(%q): expected an error", value)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action                   = &serverTakeBackupAction{}
+	_ action.ActionWithConfigure      = &serverTakeBackupAction{}
+	_ action.ActionWithValidateConfig = &serverTakeBackupAction{}
+)
+
+func NewServerTakeBackupAction() action.Action {
+	return &serverTakeBackupAction{}
+}
+
+type serverTakeBackupAction struct {
+	bc *BinarylaneClient
+}
+
+type serverTakeBackupActionModel struct {
+	ServerId            types.Int64  `tfsdk:"server_id"`
+	ReplacementStrategy types.String `tfsdk:"replacement_strategy"`
+	BackupType          types.String `tfsdk:"backup_type"`
+	BackupIdToReplace   types.Int64  `tfsdk:"backup_id_to_replace"`
+	Label               types.String `tfsdk:"label"`
+}
+
+func (a *serverTakeBackupAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.bc = &bc
+}
+
+func (a *serverTakeBackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_take_backup"
+}
+
+func (a *serverTakeBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Take a backup of a BinaryLane server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": serverActionServerIdAttribute(),
+			"replacement_strategy": schema.StringAttribute{
+				Description: "The strategy for selecting which backup to replace (if any). One of: none, specified, " +
+					"oldest, newest.",
+				MarkdownDescription: "The strategy for selecting which backup to replace (if any):\n" +
+					"  - `none`: Do not replace any existing backup, fails if the backup slots are full.\n" +
+					"  - `specified`: Replace the backup specified by `backup_id_to_replace`.\n" +
+					"  - `oldest`: Replace the oldest backup of `backup_type`.\n" +
+					"  - `newest`: Replace the newest backup of `backup_type`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(binarylane.BackupReplacementStrategyNone),
+						string(binarylane.BackupReplacementStrategySpecified),
+						string(binarylane.BackupReplacementStrategyOldest),
+						string(binarylane.BackupReplacementStrategyNewest),
+					),
+				},
+			},
+			"backup_type": schema.StringAttribute{
+				Description: "The type of backup to take, one of: daily, weekly, monthly, temporary. Required unless " +
+					"replacement_strategy is specified.",
+				MarkdownDescription: "The type of backup to take, one of: `daily`, `weekly`, `monthly`, `temporary`. " +
+					"Required unless `replacement_strategy` is `specified`. Temporary backups are retained for a maximum of " +
+					"seven days.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(binarylane.Daily),
+						string(binarylane.Weekly),
+						string(binarylane.Monthly),
+						string(binarylane.Temporary),
+					),
+				},
+			},
+			"backup_id_to_replace": schema.Int64Attribute{
+				Description:         "The ID of the existing backup to replace. Required if replacement_strategy is specified.",
+				MarkdownDescription: "The ID of the existing backup to replace. Required if `replacement_strategy` is `specified`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description:         "An optional label to identify the backup.",
+				MarkdownDescription: "An optional label to identify the backup.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *serverTakeBackupAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data serverTakeBackupActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ReplacementStrategy.IsUnknown() || data.ReplacementStrategy.IsNull() {
+		return
+	}
+
+	if data.ReplacementStrategy.ValueString() == string(binarylane.BackupReplacementStrategySpecified) {
+		if data.BackupIdToReplace.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backup_id_to_replace"),
+				"Missing backup to replace",
+				"backup_id_to_replace must be set when replacement_strategy is \"specified\".",
+			)
+		}
+	} else {
+		if data.BackupType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backup_type"),
+				"Missing backup type",
+				fmt.Sprintf("backup_type must be set when replacement_strategy is %q.", data.ReplacementStrategy.ValueString()),
+			)
+		}
+		if !data.BackupIdToReplace.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backup_id_to_replace"),
+				"Unexpected backup to replace",
+				"backup_id_to_replace can only be set when replacement_strategy is \"specified\".",
+			)
+		}
+	}
+}
+
+func (a *serverTakeBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data serverTakeBackupActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueInt64()
+
+	body := binarylane.PostServersServerIdActionsTakeBackupJSONRequestBody{
+		Type:                "take_backup",
+		ReplacementStrategy: binarylane.BackupReplacementStrategy(data.ReplacementStrategy.ValueString()),
+		BackupIdToReplace:   data.BackupIdToReplace.ValueInt64Pointer(),
+		Label:               data.Label.ValueStringPointer(),
+	}
+	if !data.BackupType.IsNull() {
+		backupType := binarylane.BackupSlot(data.BackupType.ValueString())
+		body.BackupType = &backupType
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Taking backup of server: server_id=%d", serverId))
+	backupResp, err := a.bc.client.PostServersServerIdActionsTakeBackupWithResponse(ctx, serverId, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error taking backup of server: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+	actionId, err := serverActionId(backupResp.StatusCode(), backupResp.Status(), backupResp.Body, backupResp.JSON200)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error taking backup of server: server_id=%d", serverId),
+			err.Error(),
+		)
+		return
+	}
+
+	err = waitForServerActionWithProgressEvents(ctx, a.bc, serverId, actionId, resp)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for server backup", err.Error())
+		return
+	}
+}
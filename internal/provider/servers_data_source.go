@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &serversDataSource{}
+	_ datasource.DataSourceWithConfigure      = &serversDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &serversDataSource{}
+)
+
+func NewServersDataSource() datasource.DataSource {
+	return &serversDataSource{}
+}
+
+type serversDataSource struct {
+	bc *BinarylaneClient
+}
+
+type serversDataSourceModel struct {
+	Name       types.String      `tfsdk:"name"`
+	NameRegex  types.String      `tfsdk:"name_regex"`
+	Region     types.String      `tfsdk:"region"`
+	Size       types.String      `tfsdk:"size"`
+	Image      types.String      `tfsdk:"image"`
+	VpcId      types.Int64       `tfsdk:"vpc_id"`
+	PowerState types.String      `tfsdk:"power_state"`
+	Servers    []serverDataModel `tfsdk:"servers"`
+}
+
+func (d *serversDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *serversDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData))
+		return
+	}
+
+	d.bc = &bc
+}
+
+func (d *serversDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Each server has the same attributes as the binarylane_server data source
+	serverResp := datasource.SchemaResponse{}
+	(&serverDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &serverResp)
+	resp.Diagnostics.Append(serverResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverAttributes := serverResp.Schema.Attributes
+	serverAttributes["id"] = schema.Int64Attribute{
+		Description:         "The ID of the server.",
+		MarkdownDescription: "The ID of the server.",
+		Computed:            true,
+	}
+	serverAttributes["name"] = schema.StringAttribute{
+		Description:         "The hostname of the server.",
+		MarkdownDescription: "The hostname of the server.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the BinaryLane servers that match all of the specified filters. If no filters are " +
+			"specified, all servers in the account are returned.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only return the server with this hostname (case insensitive).",
+				MarkdownDescription: "Only return the server with this hostname (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description:         "Only return servers with a hostname that matches this regular expression (RE2 syntax).",
+				MarkdownDescription: "Only return servers with a hostname that matches this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description:         "Only return servers in this region, e.g. `per`.",
+				MarkdownDescription: "Only return servers in this region, e.g. `per`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"size": schema.StringAttribute{
+				Description:         "Only return servers of this size, e.g. `std-min`.",
+				MarkdownDescription: "Only return servers of this size, e.g. `std-min`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"image": schema.StringAttribute{
+				Description:         "Only return servers that were built from this image, e.g. `debian-12`.",
+				MarkdownDescription: "Only return servers that were built from this image, e.g. `debian-12`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"vpc_id": schema.Int64Attribute{
+				Description:         "Only return servers that are members of this VPC.",
+				MarkdownDescription: "Only return servers that are members of this VPC.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"power_state": schema.StringAttribute{
+				Description: "Only return servers with this status, one of: running, stopped, new, archive. running and " +
+					"stopped match the power_state attribute of each server, so running includes new servers and " +
+					"stopped includes archive servers.",
+				MarkdownDescription: "Only return servers with this status, one of: `running`, `stopped`, `new`, `archive`. " +
+					"`running` and `stopped` match the `power_state` attribute of each server, so `running` includes `new` " +
+					"servers and `stopped` includes `archive` servers.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						serverPowerStateRunning,
+						serverPowerStateStopped,
+						string(binarylane.New),
+						string(binarylane.Archive),
+					),
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				Description:         "The servers that match the filters, ordered by ID.",
+				MarkdownDescription: "The servers that match the filters, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *serversDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data serversDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid regular expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serversDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	// Read API call logic
+	tflog.Debug(ctx, "Listing servers")
+	servers, err := d.bc.listServers(ctx, data.Name.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing servers", err.Error())
+		return
+	}
+
+	servers = slices.DeleteFunc(servers, func(server binarylane.Server) bool {
+		return !serverMatchesFilters(&data, nameRegex, &server)
+	})
+	slices.SortFunc(servers, func(a, b binarylane.Server) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	data.Servers = make([]serverDataModel, len(servers))
+	for i := range servers {
+		server := &data.Servers[i]
+		resp.Diagnostics.Append(setServerDataModelState(ctx, server, &servers[i])...)
+
+		// SSH keys are only used when the server is created, and are not returned by the API
+		server.SshKeys = types.ListNull(types.Int64Type)
+
+		// Get user data script
+		userDataResp, err := d.bc.client.GetServersServerIdUserDataWithResponse(ctx, servers[i].Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading server user data: id=%d, name=%s", servers[i].Id, servers[i].Name),
+				err.Error(),
+			)
+			return
+		}
+		if userDataResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Unexpected HTTP status code reading server user data",
+				fmt.Sprintf("Received %s reading server user data: id=%d, name=%s. Details: %s", userDataResp.Status(),
+					servers[i].Id, servers[i].Name, userDataResp.Body))
+			return
+		}
+		server.UserData = types.StringPointerValue(userDataResp.JSON200.UserData)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func serverMatchesFilters(data *serversDataSourceModel, nameRegex *regexp.Regexp, server *binarylane.Server) bool {
+	if nameRegex != nil && !nameRegex.MatchString(server.Name) {
+		return false
+	}
+	if !data.Region.IsNull() && !strings.EqualFold(server.Region.Slug, data.Region.ValueString()) {
+		return false
+	}
+	if !data.Size.IsNull() && !strings.EqualFold(server.Size.Slug, data.Size.ValueString()) {
+		return false
+	}
+	if !data.Image.IsNull() && (server.Image.Slug == nil || !strings.EqualFold(*server.Image.Slug, data.Image.ValueString())) {
+		return false
+	}
+	if !data.VpcId.IsNull() && (server.VpcId == nil || *server.VpcId != data.VpcId.ValueInt64()) {
+		return false
+	}
+	if !data.PowerState.IsNull() && !serverMatchesPowerState(server.Status, data.PowerState.ValueString()) {
+		return false
+	}
+	return true
+}
+
+// serverMatchesPowerState returns true if a server with the status matches the power_state filter. The new and archive
+// filters match the status of the server, while running and stopped match its power_state, which includes new and
+// archived servers respectively.
+func serverMatchesPowerState(status binarylane.ServerStatus, powerState string) bool {
+	switch powerState {
+	case string(binarylane.New), string(binarylane.Archive):
+		return string(status) == powerState
+	default:
+		return serverPowerState(status).ValueString() == powerState
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"slices"
+	"terraform-provider-binarylane/internal/binarylane"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestServersDataSource(t *testing.T) {
+	// Must assign a password to the server or Binary Lane will send emails
+	password := GenerateTestPassword(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "binarylane_vpc" "test" {
+	name     = "tf-test-servers-data-source"
+	ip_range = "10.240.0.0/16"
+}
+
+resource "binarylane_server" "test" {
+	name              = "tf-test-servers-data-source"
+	region            = "per"
+	image             = "debian-12"
+	size              = "std-min"
+	vpc_id            = binarylane_vpc.test.id
+	public_ipv4_count = 0
+	password          = "` + password + `"
+}
+
+data "binarylane_servers" "by_vpc" {
+	vpc_id = binarylane_vpc.test.id
+
+	depends_on = [binarylane_server.test]
+}
+
+data "binarylane_servers" "by_name_regex" {
+	name_regex  = "^tf-test-servers-data-.*$"
+	region      = "per"
+	power_state = "running"
+
+	depends_on = [binarylane_server.test]
+}
+
+data "binarylane_servers" "none" {
+	name = "tf-test-servers-data-source"
+	size = "std-max"
+
+	depends_on = [binarylane_server.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_servers.by_vpc", "servers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.binarylane_servers.by_vpc", "servers.0.id", "binarylane_server.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_servers.by_vpc", "servers.0.name", "tf-test-servers-data-source"),
+					resource.TestCheckResourceAttr("data.binarylane_servers.by_vpc", "servers.0.image", "debian-12"),
+					resource.TestCheckResourceAttrPair("data.binarylane_servers.by_vpc", "servers.0.vpc_id", "binarylane_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair("data.binarylane_servers.by_vpc", "servers.0.vpc_ipv4_address", "binarylane_server.test", "vpc_ipv4_address"),
+					resource.TestCheckResourceAttr("data.binarylane_servers.by_name_regex", "servers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.binarylane_servers.by_name_regex", "servers.0.id", "binarylane_server.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_servers.none", "servers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerMatchesPowerState(t *testing.T) {
+	statuses := []binarylane.ServerStatus{binarylane.Active, binarylane.Off, binarylane.New, binarylane.Archive}
+
+	testCases := map[string][]binarylane.ServerStatus{
+		serverPowerStateRunning:    {binarylane.Active, binarylane.New},
+		serverPowerStateStopped:    {binarylane.Off, binarylane.Archive},
+		string(binarylane.New):     {binarylane.New},
+		string(binarylane.Archive): {binarylane.Archive},
+	}
+
+	for powerState, expected := range testCases {
+		for _, status := range statuses {
+			if actual := serverMatchesPowerState(status, powerState); actual != slices.Contains(expected, status) {
+				t.Errorf("serverMatchesPowerState(%q, %q): expected %t, got: %t", status, powerState, !actual, actual)
+			}
+		}
+	}
+}
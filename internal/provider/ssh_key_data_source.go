@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
 	"terraform-provider-binarylane/internal/resources"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &sshKeyDataSource{}
-	_ datasource.DataSourceWithConfigure = &sshKeyDataSource{}
+	_ datasource.DataSource                     = &sshKeyDataSource{}
+	_ datasource.DataSourceWithConfigure        = &sshKeyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &sshKeyDataSource{}
 )
 
 func NewSshKeyDataSource() datasource.DataSource {
@@ -51,7 +57,7 @@ func (d *sshKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	ds, err := convertResourceSchemaToDataSourceSchema(
 		resources.SshKeyResourceSchema(ctx),
 		AttributeConfig{
-			RequiredAttributes: &[]string{"id"},
+			OptionalAttributes: &[]string{"id", "name"},
 		},
 	)
 	if err != nil {
@@ -59,8 +65,24 @@ func (d *sshKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 		return
 	}
 	resp.Schema = *ds
+	resp.Schema.Description = "Retrieve details about a BinaryLane SSH key, by either `id`, `name` or `fingerprint`."
 
 	// Overrides
+	idDescription := "The ID of the SSH key to fetch. Exactly one of `id`, `name` or `fingerprint` must be specified."
+	resp.Schema.Attributes["id"] = schema.Int64Attribute{
+		Description:         idDescription,
+		MarkdownDescription: idDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+	nameDescription := "The name of the SSH key to fetch (case insensitive). Exactly one of `id`, `name` or `fingerprint` must be specified."
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription,
+		MarkdownDescription: nameDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+
 	defaultDescription := "If `true`, this SSH key will be included on all new server installations (if the operating " +
 		"system supports SSH key injection)."
 	resp.Schema.Attributes["default"] = schema.BoolAttribute{
@@ -81,16 +103,23 @@ func (d *sshKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 		Computed:            true,
 	}
 
-	fingerprintDescription := "The fingerprint of the SSH key."
+	fingerprintDescription := "The fingerprint of the SSH key to fetch. Exactly one of `id`, `name` or `fingerprint` " +
+		"must be specified."
 	resp.Schema.Attributes["fingerprint"] = schema.StringAttribute{
 		Description:         fingerprintDescription,
 		MarkdownDescription: fingerprintDescription,
-		Optional:            false,
+		Optional:            true,
 		Required:            false,
 		Computed:            true,
 	}
 }
 
+func (d *sshKeyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("fingerprint")),
+	}
+}
+
 func (d *sshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data sshKeyModel
 
@@ -101,6 +130,38 @@ func (d *sshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	configName := data.Name
+
+	// Look up SSH key by name or fingerprint
+	if data.Id.IsNull() {
+		sshKeys, err := d.bc.listSshKeys(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting SSH keys", err.Error())
+			return
+		}
+
+		var id int64
+		var diags diag.Diagnostics
+		if !data.Name.IsNull() {
+			name := data.Name.ValueString()
+			id, diags = lookupId(sshKeys,
+				func(k binarylane.SshKey) int64 { return k.Id },
+				func(k binarylane.SshKey) bool { return k.Name != nil && strings.EqualFold(*k.Name, name) },
+				"SSH key", "name", name)
+		} else {
+			fingerprint := data.Fingerprint.ValueString()
+			id, diags = lookupId(sshKeys,
+				func(k binarylane.SshKey) int64 { return k.Id },
+				func(k binarylane.SshKey) bool { return k.Fingerprint == fingerprint },
+				"SSH key", "fingerprint", fingerprint)
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
+
 	// Read API call logic
 	sshResp, err := d.bc.client.GetAccountKeysKeyIdWithResponse(ctx, int(data.Id.ValueInt64()))
 	if err != nil {
@@ -126,6 +187,11 @@ func (d *sshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 	data.Fingerprint = types.StringValue(sshResp.JSON200.SshKey.Fingerprint)
 
+	// Names are matched case insensitively, so keep the configured name
+	if !configName.IsNull() {
+		data.Name = configName
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
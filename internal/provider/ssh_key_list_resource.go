@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &sshKeyListResource{}
+	_ list.ListResourceWithConfigure = &sshKeyListResource{}
+)
+
+func NewSshKeyListResource() list.ListResource {
+	return &sshKeyListResource{}
+}
+
+type sshKeyListResource struct {
+	bc *BinarylaneClient
+}
+
+type sshKeyListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *sshKeyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *sshKeyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *sshKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List existing BinaryLane SSH keys, for use with `terraform query` to discover and import SSH keys.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only list SSH keys with this name (case insensitive).",
+				MarkdownDescription: "Only list SSH keys with this name (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *sshKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config sshKeyListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing SSH keys: name=%s", config.Name.ValueString()))
+	sshKeys, err := r.bc.listSshKeys(ctx)
+	if err != nil {
+		diags.AddError("Error listing SSH keys", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !config.Name.IsNull() {
+		sshKeys = slices.DeleteFunc(sshKeys, func(sshKey binarylane.SshKey) bool {
+			return sshKey.Name == nil || !strings.EqualFold(*sshKey.Name, config.Name.ValueString())
+		})
+	}
+
+	stream.Results = listResults(req, sshKeys, func(sshKey binarylane.SshKey) list.ListResult {
+		displayName := sshKey.Fingerprint
+		if sshKey.Name != nil {
+			displayName = *sshKey.Name
+		}
+		return newListResult(ctx, req, sshKey.Id, displayName, func(data *sshKeyModel) diag.Diagnostics {
+			data.Id = types.Int64Value(sshKey.Id)
+			data.Default = types.BoolValue(sshKey.Default)
+			data.Name = types.StringPointerValue(sshKey.Name)
+			data.PublicKey = TrimmedStringValue{
+				StringValue: types.StringValue(sshKey.PublicKey),
+			}
+			data.Fingerprint = types.StringValue(sshKey.Fingerprint)
+			return nil
+		})
+	})
+}
+
+func (bc *BinarylaneClient) listSshKeys(ctx context.Context) ([]binarylane.SshKey, error) {
+	var items []binarylane.SshKey
+	var page int32 = 1
+	perPage := int32(200)
+
+	for {
+		params := binarylane.GetAccountKeysParams{
+			Page:    &page,
+			PerPage: &perPage,
+		}
+
+		listResp, err := bc.client.GetAccountKeysWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing SSH keys: page=%d, error: %w", page, err)
+		}
+		if listResp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status code listing SSH keys: page=%d, status=%s, details: %s",
+				page, listResp.Status(), listResp.Body)
+		}
+
+		items = append(items, listResp.JSON200.SshKeys...)
+		if listResp.JSON200.Links == nil || listResp.JSON200.Links.Pages.Next == nil {
+			return items, nil
+		}
+
+		page++
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSshKeyListResource(t *testing.T) {
+	publicKey := GenerateTestPublicKey(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "binarylane_ssh_key" "test" {
+  name       = "tf-test-ssh-key-list"
+  public_key = "` + publicKey + `"
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "binarylane_ssh_key" "test" {
+  provider = binarylane
+
+  include_resource = true
+
+  config {
+    name = "tf-test-ssh-key-list"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("binarylane_ssh_key.test", 1),
+					querycheck.ExpectIdentity("binarylane_ssh_key.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceDisplayName("binarylane_ssh_key.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-ssh-key-list")),
+						knownvalue.StringExact("tf-test-ssh-key-list"),
+					),
+					querycheck.ExpectResourceKnownValues("binarylane_ssh_key.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-ssh-key-list")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("public_key"),
+								KnownValue: knownvalue.StringExact(publicKey),
+							},
+							{
+								Path:       tfjsonpath.New("default"),
+								KnownValue: knownvalue.Bool(false),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &sshKeyResource{}
 	_ resource.ResourceWithConfigure   = &sshKeyResource{}
 	_ resource.ResourceWithImportState = &sshKeyResource{}
+	_ resource.ResourceWithIdentity    = &sshKeyResource{}
 )
 
 func NewSshKeyResource() resource.Resource {
@@ -117,6 +118,10 @@ func (r *sshKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *sshKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idResourceIdentitySchema("The ID of the SSH key.")
+}
+
 func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data sshKeyModel
 
@@ -161,6 +166,7 @@ func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -200,6 +206,7 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.Fingerprint = types.StringValue(sshResp.JSON200.SshKey.Fingerprint)
 
 	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -276,6 +283,12 @@ func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *sshKeyResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	// Import by identity
+	if req.ID == "" {
+		importStateByIdentity(ctx, req, resp)
+		return
+	}
+
 	// Import by ID
 	id, err := strconv.ParseInt(req.ID, 10, 32)
 	if err == nil {
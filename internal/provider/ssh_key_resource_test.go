@@ -36,6 +36,16 @@ data "binarylane_ssh_key" "test" {
 
   id = binarylane_ssh_key.test.id
 }
+
+data "binarylane_ssh_key" "by_name" {
+  depends_on = [binarylane_ssh_key.test]
+
+  name = "tf-test-key-resource-test"
+}
+
+data "binarylane_ssh_key" "by_fingerprint" {
+  fingerprint = binarylane_ssh_key.test.fingerprint
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify resource values
@@ -51,6 +61,9 @@ data "binarylane_ssh_key" "test" {
 					resource.TestCheckResourceAttrSet("data.binarylane_ssh_key.test", "fingerprint"),
 					resource.TestCheckResourceAttr("data.binarylane_ssh_key.test", "default", "false"),
 					resource.TestCheckResourceAttrSet("data.binarylane_ssh_key.test", "id"),
+					resource.TestCheckResourceAttrPair("data.binarylane_ssh_key.by_name", "id", "binarylane_ssh_key.test", "id"),
+					resource.TestCheckResourceAttrPair("data.binarylane_ssh_key.by_fingerprint", "id", "binarylane_ssh_key.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_ssh_key.by_fingerprint", "name", "tf-test-key-resource-test"),
 				),
 			},
 			// ImportState testing
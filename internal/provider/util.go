@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	d_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -266,3 +267,27 @@ func listContainsUnknown(ctx context.Context, list types.List) bool {
 func Pointer[T any](d T) *T {
 	return &d
 }
+
+// keyedMutex provides a separate lock for each key, so that read-modify-write changes to one object (e.g. the firewall
+// rules of a server) are serialised without blocking changes to other objects.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[int64]*sync.Mutex
+}
+
+// lock waits for the lock on key to be acquired, and returns a function that releases it.
+func (m *keyedMutex) lock(key int64) (unlock func()) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[int64]*sync.Mutex)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[key] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var (
-	_ validator.Int32 = &MultipleOfValidator{}
+	_ validator.Int32   = &MultipleOfValidator{}
+	_ validator.Float64 = &TransferValidator{}
 )
 
 type MultipleOfValidator struct {
@@ -33,9 +35,7 @@ func (v MultipleOfValidator) ValidateInt32(ctx context.Context, req validator.In
 	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
 		return
 	}
-	memory := req.ConfigValue.ValueInt32()
-
-	if memory%v.Multiple == 0 || v.RangeFrom != 0 && memory < v.RangeFrom || v.RangeTo != 0 && memory >= v.RangeTo {
+	if v.isValid(int64(req.ConfigValue.ValueInt32())) {
 		return
 	}
 
@@ -53,3 +53,70 @@ func (v MultipleOfValidator) ValidateInt32(ctx context.Context, req validator.In
 		)
 	}
 }
+
+// isValid returns true if the value is a multiple, or is outside the range to which the validator applies.
+func (v MultipleOfValidator) isValid(value int64) bool {
+	return value%int64(v.Multiple) == 0 ||
+		v.RangeFrom != 0 && value < int64(v.RangeFrom) ||
+		v.RangeTo != 0 && value >= int64(v.RangeTo)
+}
+
+func multipleOfInt32Validators(multiples []MultipleOfValidator) []validator.Int32 {
+	validators := make([]validator.Int32, len(multiples))
+	for i, v := range multiples {
+		validators[i] = v
+	}
+	return validators
+}
+
+// TransferValidator validates that an amount of transfer in TB is a whole number of GB that satisfies each of the
+// multiples, which are in GB.
+type TransferValidator struct {
+	Multiples []MultipleOfValidator
+}
+
+func (v TransferValidator) Description(ctx context.Context) string {
+	return "when converted to GB, must be a whole number that satisfies the valid values of transfer"
+}
+
+func (v TransferValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TransferValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	tb := req.ConfigValue.ValueFloat64()
+	gb := math.Round(tb * 1000)
+	if math.Abs(tb*1000-gb) > 1e-6 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Transfer",
+			fmt.Sprintf("%g TB is not a whole number of GB", tb),
+		)
+		return
+	}
+
+	for _, m := range v.Multiples {
+		if m.isValid(int64(gb)) {
+			continue
+		}
+		if m.RangeFrom != 0 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Not a Multiple",
+				fmt.Sprintf("%g TB is %d GB, and if greater than %d GB, value must be a multiple of %d GB", tb, int64(gb),
+					m.RangeFrom, m.Multiple),
+			)
+		} else {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Not a Multiple",
+				fmt.Sprintf("%g TB is %d GB, and value must be a multiple of %d GB", tb, int64(gb), m.Multiple),
+			)
+		}
+		return
+	}
+}
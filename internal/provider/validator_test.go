@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransferValidator(t *testing.T) {
+	testCases := map[float64]bool{
+		0.005:  true,
+		0.025:  true,
+		0.03:   true,
+		0.035:  false,
+		0.15:   true,
+		0.25:   false,
+		0.3:    true,
+		1.5:    true,
+		2:      true,
+		2.5:    false,
+		3:      true,
+		0.0055: false,
+	}
+
+	v := TransferValidator{Multiples: serverTransferMultiples}
+	for transfer, expected := range testCases {
+		req := validator.Float64Request{
+			Path:        path.Root("transfer"),
+			ConfigValue: types.Float64Value(transfer),
+		}
+		resp := &validator.Float64Response{}
+		v.ValidateFloat64(context.Background(), req, resp)
+
+		if actual := !resp.Diagnostics.HasError(); actual != expected {
+			t.Errorf("transfer %g: expected valid to be %t, got: %v", transfer, expected, resp.Diagnostics)
+		}
+	}
+}
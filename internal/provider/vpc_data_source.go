@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
 	"terraform-provider-binarylane/internal/resources"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &sshKeyDataSource{}
-	_ datasource.DataSourceWithConfigure = &sshKeyDataSource{}
+	_ datasource.DataSource                     = &vpcDataSource{}
+	_ datasource.DataSourceWithConfigure        = &vpcDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &vpcDataSource{}
 )
 
 func NewVpcDataSource() datasource.DataSource {
@@ -53,7 +59,7 @@ func (d *vpcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 	ds, err := convertResourceSchemaToDataSourceSchema(
 		resources.VpcResourceSchema(ctx),
 		AttributeConfig{
-			RequiredAttributes: &[]string{"id"},
+			OptionalAttributes: &[]string{"id", "name"},
 		},
 	)
 	if err != nil {
@@ -62,7 +68,29 @@ func (d *vpcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 	}
 
 	resp.Schema = *ds
-	// resp.Schema.Description = "TODO"
+	resp.Schema.Description = "Retrieve details about a BinaryLane VPC, by either `id` or `name`."
+
+	// Overrides
+	idDescription := "The ID of the VPC to fetch. Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["id"] = schema.Int64Attribute{
+		Description:         idDescription,
+		MarkdownDescription: idDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+	nameDescription := "The name of the VPC to fetch (case insensitive). Exactly one of `id` or `name` must be specified."
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription,
+		MarkdownDescription: nameDescription,
+		Optional:            true,
+		Computed:            true,
+	}
+}
+
+func (d *vpcDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
 }
 
 func (d *vpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -75,6 +103,27 @@ func (d *vpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	configName := data.Name
+
+	// Look up VPC by name
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		vpcs, err := d.bc.listVpcs(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting VPC: name=%s", name), err.Error())
+			return
+		}
+		id, diags := lookupId(vpcs,
+			func(v binarylane.Vpc) int64 { return v.Id },
+			func(v binarylane.Vpc) bool { return strings.EqualFold(v.Name, name) },
+			"VPC", "name", name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
+
 	// Read API call logic
 	vpcResp, err := d.bc.client.GetVpcsVpcIdWithResponse(ctx, data.Id.ValueInt64())
 	if err != nil {
@@ -93,6 +142,11 @@ func (d *vpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.IpRange = types.StringValue(vpcResp.JSON200.Vpc.IpRange)
 	data.Name = types.StringValue(vpcResp.JSON200.Vpc.Name)
 
+	// Names are matched case insensitively, so keep the configured name
+	if !configName.IsNull() {
+		data.Name = configName
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
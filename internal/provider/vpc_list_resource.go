@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &vpcListResource{}
+	_ list.ListResourceWithConfigure = &vpcListResource{}
+)
+
+func NewVpcListResource() list.ListResource {
+	return &vpcListResource{}
+}
+
+type vpcListResource struct {
+	bc *BinarylaneClient
+}
+
+type vpcListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *vpcListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.bc = &bc
+}
+
+func (r *vpcListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc"
+}
+
+func (r *vpcListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List existing BinaryLane VPCs, for use with `terraform query` to discover and import VPCs.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Only list VPCs with this name (case insensitive).",
+				MarkdownDescription: "Only list VPCs with this name (case insensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *vpcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config vpcListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing VPCs: name=%s", config.Name.ValueString()))
+	vpcs, err := r.bc.listVpcs(ctx)
+	if err != nil {
+		diags.AddError("Error listing VPCs", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !config.Name.IsNull() {
+		vpcs = slices.DeleteFunc(vpcs, func(vpc binarylane.Vpc) bool {
+			return !strings.EqualFold(vpc.Name, config.Name.ValueString())
+		})
+	}
+
+	stream.Results = listResults(req, vpcs, func(vpc binarylane.Vpc) list.ListResult {
+		return newListResult(ctx, req, vpc.Id, vpc.Name, func(data *vpcResourceModel) diag.Diagnostics {
+			data.Id = types.Int64Value(vpc.Id)
+			data.IpRange = types.StringValue(vpc.IpRange)
+			data.Name = types.StringValue(vpc.Name)
+			return nil
+		})
+	})
+}
+
+func (bc *BinarylaneClient) listVpcs(ctx context.Context) ([]binarylane.Vpc, error) {
+	var items []binarylane.Vpc
+	var page int32 = 1
+	perPage := int32(200)
+
+	for {
+		params := binarylane.GetVpcsParams{
+			Page:    &page,
+			PerPage: &perPage,
+		}
+
+		listResp, err := bc.client.GetVpcsWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing VPCs: page=%d, error: %w", page, err)
+		}
+		if listResp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("unexpected HTTP status code listing VPCs: page=%d, status=%s, details: %s",
+				page, listResp.Status(), listResp.Body)
+		}
+
+		items = append(items, listResp.JSON200.Vpcs...)
+		if listResp.JSON200.Links == nil || listResp.JSON200.Links.Pages.Next == nil {
+			return items, nil
+		}
+
+		page++
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestVpcListResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "binarylane_vpc" "test" {
+  name     = "tf-test-vpc-list"
+  ip_range = "10.240.0.0/16"
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "binarylane_vpc" "test" {
+  provider = binarylane
+
+  include_resource = true
+
+  config {
+    name = "tf-test-vpc-list"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("binarylane_vpc.test", 1),
+					querycheck.ExpectResourceDisplayName("binarylane_vpc.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-vpc-list")),
+						knownvalue.StringExact("tf-test-vpc-list"),
+					),
+					querycheck.ExpectResourceKnownValues("binarylane_vpc.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf-test-vpc-list")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("ip_range"),
+								KnownValue: knownvalue.StringExact("10.240.0.0/16"),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
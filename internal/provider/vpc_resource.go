@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                = &vpcResource{}
 	_ resource.ResourceWithConfigure   = &vpcResource{}
 	_ resource.ResourceWithImportState = &vpcResource{}
+	_ resource.ResourceWithIdentity    = &vpcResource{}
 )
 
 func NewVpcResource() resource.Resource {
@@ -86,6 +87,10 @@ func (r *vpcResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *vpcResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idResourceIdentitySchema("The ID of the VPC.")
+}
+
 func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data vpcResourceModel
 
@@ -124,6 +129,7 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	data.Name = types.StringValue(vpcResp.JSON200.Vpc.Name)
 
 	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -162,6 +168,7 @@ func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.Name = types.StringValue(vpcResp.JSON200.Vpc.Name)
 
 	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idResourceIdentityModel{Id: data.Id})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -239,6 +246,12 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *vpcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by identity
+	if req.ID == "" {
+		importStateByIdentity(ctx, req, resp)
+		return
+	}
+
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
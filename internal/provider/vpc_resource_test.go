@@ -32,6 +32,12 @@ data "binarylane_vpc" "test" {
   id = binarylane_vpc.test.id
 }
 
+data "binarylane_vpc" "by_name" {
+  depends_on = [binarylane_vpc.test]
+
+  name = "TF-TEST-VPC"
+}
+
 resource "binarylane_vpc_route_entries" "test" {
   vpc_id = binarylane_vpc.test.id
   route_entries = [
@@ -56,6 +62,9 @@ data "binarylane_vpc_route_entries" "test" {
 					resource.TestCheckResourceAttr("data.binarylane_vpc.test", "name", "tf-test-vpc"),
 					resource.TestCheckResourceAttr("data.binarylane_vpc.test", "ip_range", "10.240.0.0/16"),
 					resource.TestCheckResourceAttrSet("data.binarylane_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair("data.binarylane_vpc.by_name", "id", "binarylane_vpc.test", "id"),
+					resource.TestCheckResourceAttr("data.binarylane_vpc.by_name", "ip_range", "10.240.0.0/16"),
+					resource.TestCheckResourceAttr("data.binarylane_vpc.by_name", "name", "TF-TEST-VPC"),
 					// Verify binarylane_vpc_route_entries resource values
 					resource.TestCheckResourceAttrSet("binarylane_vpc_route_entries.test", "vpc_id"),
 					resource.TestCheckResourceAttr("binarylane_vpc_route_entries.test", "route_entries.#", "1"),
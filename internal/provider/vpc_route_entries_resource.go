@@ -76,6 +76,9 @@ func (r *vpcRouteEntriesResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	unlock := r.bc.vpcLocks.lock(data.VpcId.ValueInt64())
+	defer unlock()
+
 	vpcResp, err := r.bc.client.PatchVpcsVpcIdWithResponse(ctx, data.VpcId.ValueInt64(), binarylane.PatchVpcRequest{
 		RouteEntries: &routeEntries,
 	})
@@ -165,6 +168,9 @@ func (r *vpcRouteEntriesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	unlock := r.bc.vpcLocks.lock(state.VpcId.ValueInt64())
+	defer unlock()
+
 	vpcResp, err := r.bc.client.PatchVpcsVpcIdWithResponse(ctx, state.VpcId.ValueInt64(), binarylane.PatchVpcRequest{
 		RouteEntries: &routeEntries,
 	})
@@ -205,6 +211,9 @@ func (r *vpcRouteEntriesResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete API call logic
+	unlock := r.bc.vpcLocks.lock(data.VpcId.ValueInt64())
+	defer unlock()
+
 	var routeEntries []binarylane.RouteEntryRequest
 	vpcResp, err := r.bc.client.PatchVpcsVpcIdWithResponse(ctx, data.VpcId.ValueInt64(), binarylane.PatchVpcRequest{
 		RouteEntries: &routeEntries,
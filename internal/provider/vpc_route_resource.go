@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-binarylane/internal/binarylane"
+	"terraform-provider-binarylane/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &vpcRouteResource{}
+	_ resource.ResourceWithConfigure   = &vpcRouteResource{}
+	_ resource.ResourceWithImportState = &vpcRouteResource{}
+)
+
+func NewVpcRouteResource() resource.Resource {
+	return &vpcRouteResource{}
+}
+
+type vpcRouteResource struct {
+	bc *BinarylaneClient
+}
+
+type vpcRouteResourceModel struct {
+	VpcId       types.Int64  `tfsdk:"vpc_id"`
+	Destination types.String `tfsdk:"destination"`
+	Router      types.String `tfsdk:"router"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *vpcRouteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	bc, ok := req.ProviderData.(BinarylaneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *BinarylaneClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.bc = &bc
+}
+
+func (r *vpcRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_route"
+}
+
+func (r *vpcRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Each attribute of the route has the same description as the binarylane_vpc_route_entries resource
+	routeEntriesSchema := resources.VpcRouteEntriesResourceSchema(ctx)
+	routeEntries := routeEntriesSchema.Attributes["route_entries"].(schema.ListNestedAttribute)
+	route := routeEntries.NestedObject.Attributes
+
+	resp.Schema = schema.Schema{
+		Description: "Provides a single route entry of a BinaryLane VPC, without affecting any other route entries of " +
+			"the VPC. The route entry is identified by its destination. Do not use with binarylane_vpc_route_entries " +
+			"for the same VPC, which would remove the route entry.",
+		MarkdownDescription: "Provides a single route entry of a BinaryLane VPC, without affecting any other route " +
+			"entries of the VPC. The route entry is identified by its `destination`. Do not use with " +
+			"`binarylane_vpc_route_entries` for the same VPC, which would remove the route entry.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.Int64Attribute{
+				Description:         routeEntriesSchema.Attributes["vpc_id"].GetDescription(),
+				MarkdownDescription: routeEntriesSchema.Attributes["vpc_id"].GetMarkdownDescription(),
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description: route["destination"].GetDescription() + " Must be unique among the route entries of the " +
+					"VPC, as it identifies the route entry managed by this resource.",
+				MarkdownDescription: route["destination"].GetMarkdownDescription() + " Must be unique among the route " +
+					"entries of the VPC, as it identifies the route entry managed by this resource.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"router": schema.StringAttribute{
+				Description:         route["router"].GetDescription(),
+				MarkdownDescription: route["router"].GetMarkdownDescription(),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         route["description"].GetDescription(),
+				MarkdownDescription: route["description"].GetMarkdownDescription(),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+		},
+	}
+}
+
+func (r *vpcRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data vpcRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpcId := data.VpcId.ValueInt64()
+	destination := data.Destination.ValueString()
+
+	// Create API call logic
+	unlock := r.bc.vpcLocks.lock(vpcId)
+	defer unlock()
+
+	routes, err := r.bc.getVpcRouteEntries(ctx, vpcId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VPC route", err.Error())
+		return
+	}
+	if routes == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vpc_id"),
+			"VPC not found",
+			fmt.Sprintf("Could not find VPC: vpc_id=%d", vpcId),
+		)
+		return
+	}
+	if findRouteEntry(*routes, destination) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination"),
+			"VPC route already exists",
+			fmt.Sprintf("The VPC already has a route entry: vpc_id=%d, destination=%s. "+
+				"Import the existing route entry to manage it with this resource.", vpcId, destination),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating VPC route: vpc_id=%d, destination=%s", vpcId, destination))
+	routes, err = r.bc.changeVpcRouteEntries(ctx, vpcId, append(routeEntryRequests(*routes), data.routeEntryRequest()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VPC route", err.Error())
+		return
+	}
+
+	i := findRouteEntry(*routes, destination)
+	if i < 0 {
+		resp.Diagnostics.AddError(
+			"VPC route not found",
+			fmt.Sprintf("Could not find VPC route after creating it: vpc_id=%d, destination=%s", vpcId, destination),
+		)
+		return
+	}
+	setVpcRouteModelState(&data, &(*routes)[i])
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data vpcRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	vpcId := data.VpcId.ValueInt64()
+	destination := data.Destination.ValueString()
+	routes, err := r.bc.getVpcRouteEntries(ctx, vpcId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VPC route", err.Error())
+		return
+	}
+	var i int = -1
+	if routes != nil {
+		i = findRouteEntry(*routes, destination)
+	}
+	if i < 0 {
+		tflog.Warn(ctx, fmt.Sprintf("VPC route not found, removing from state: vpc_id=%d, destination=%s",
+			vpcId, destination))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setVpcRouteModelState(&data, &(*routes)[i])
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcRouteResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpcId := state.VpcId.ValueInt64()
+	destination := state.Destination.ValueString()
+
+	// Update API call logic
+	unlock := r.bc.vpcLocks.lock(vpcId)
+	defer unlock()
+
+	routes, err := r.bc.getVpcRouteEntries(ctx, vpcId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating VPC route", err.Error())
+		return
+	}
+	var i int = -1
+	if routes != nil {
+		i = findRouteEntry(*routes, destination)
+	}
+	if i < 0 {
+		resp.Diagnostics.AddError(
+			"VPC route not found",
+			fmt.Sprintf("Could not find VPC route to update: vpc_id=%d, destination=%s", vpcId, destination),
+		)
+		return
+	}
+
+	// Replace the route entry in place, so that the order of the other route entries is unchanged
+	requests := routeEntryRequests(*routes)
+	requests[i] = plan.routeEntryRequest()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating VPC route: vpc_id=%d, destination=%s", vpcId, destination))
+	routes, err = r.bc.changeVpcRouteEntries(ctx, vpcId, requests)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating VPC route", err.Error())
+		return
+	}
+
+	i = findRouteEntry(*routes, destination)
+	if i < 0 {
+		resp.Diagnostics.AddError(
+			"VPC route not found",
+			fmt.Sprintf("Could not find VPC route after updating it: vpc_id=%d, destination=%s", vpcId, destination),
+		)
+		return
+	}
+	setVpcRouteModelState(&plan, &(*routes)[i])
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data vpcRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpcId := data.VpcId.ValueInt64()
+	destination := data.Destination.ValueString()
+
+	// Delete API call logic
+	unlock := r.bc.vpcLocks.lock(vpcId)
+	defer unlock()
+
+	routes, err := r.bc.getVpcRouteEntries(ctx, vpcId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting VPC route", err.Error())
+		return
+	}
+	if routes == nil {
+		tflog.Warn(ctx, fmt.Sprintf("VPC not found, assuming route has been removed: vpc_id=%d", vpcId))
+		return
+	}
+	i := findRouteEntry(*routes, destination)
+	if i < 0 {
+		tflog.Warn(ctx, fmt.Sprintf("VPC route not found, assuming it has been removed: vpc_id=%d, destination=%s",
+			vpcId, destination))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting VPC route: vpc_id=%d, destination=%s", vpcId, destination))
+	_, err = r.bc.changeVpcRouteEntries(ctx, vpcId, slices.Delete(routeEntryRequests(*routes), i, i+1))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting VPC route", err.Error())
+		return
+	}
+}
+
+func (r *vpcRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is in the format "vpc_id/destination", where the destination may itself contain a "/"
+	vpcIdStr, destination, found := strings.Cut(req.ID, "/")
+	vpcId, err := strconv.ParseInt(vpcIdStr, 10, 64)
+	if !found || err != nil || destination == "" {
+		resp.Diagnostics.AddError(
+			"Error importing VPC route",
+			fmt.Sprintf("Could not import VPC route, expected import ID in the format "+
+				"\"vpc_id/destination\", got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_id"), vpcId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination"), destination)...)
+}
+
+func (data *vpcRouteResourceModel) routeEntryRequest() binarylane.RouteEntryRequest {
+	route := binarylane.RouteEntryRequest{
+		Destination: data.Destination.ValueString(),
+		Router:      data.Router.ValueString(),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		route.Description = data.Description.ValueStringPointer()
+	}
+	return route
+}
+
+func setVpcRouteModelState(data *vpcRouteResourceModel, route *binarylane.RouteEntry) {
+	data.Destination = types.StringValue(route.Destination)
+	data.Router = types.StringValue(route.Router)
+	// A route without a description is not configured with one
+	if route.Description == nil || *route.Description == "" {
+		data.Description = types.StringNull()
+	} else {
+		data.Description = types.StringValue(*route.Description)
+	}
+}
+
+// findRouteEntry returns the index of the route entry with the given destination, or -1 if there is no such route.
+func findRouteEntry(routes []binarylane.RouteEntry, destination string) int {
+	return slices.IndexFunc(routes, func(route binarylane.RouteEntry) bool {
+		return route.Destination == destination
+	})
+}
+
+func routeEntryRequests(routes []binarylane.RouteEntry) []binarylane.RouteEntryRequest {
+	requests := make([]binarylane.RouteEntryRequest, len(routes))
+	for i, route := range routes {
+		requests[i] = binarylane.RouteEntryRequest(route)
+	}
+	return requests
+}
+
+// getVpcRouteEntries returns the route entries of the VPC, or nil if the VPC does not exist.
+func (bc *BinarylaneClient) getVpcRouteEntries(ctx context.Context, vpcId int64) (*[]binarylane.RouteEntry, error) {
+	vpcResp, err := bc.client.GetVpcsVpcIdWithResponse(ctx, vpcId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading VPC route entries: vpc_id=%d, error: %w", vpcId, err)
+	}
+	if vpcResp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if vpcResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code reading VPC route entries: vpc_id=%d, status=%s, details: %s",
+			vpcId, vpcResp.Status(), vpcResp.Body)
+	}
+
+	return &vpcResp.JSON200.Vpc.RouteEntries, nil
+}
+
+// changeVpcRouteEntries replaces all route entries of the VPC, and returns the route entries after the change.
+func (bc *BinarylaneClient) changeVpcRouteEntries(
+	ctx context.Context, vpcId int64, routeEntries []binarylane.RouteEntryRequest,
+) (*[]binarylane.RouteEntry, error) {
+	vpcResp, err := bc.client.PatchVpcsVpcIdWithResponse(ctx, vpcId, binarylane.PatchVpcRequest{
+		RouteEntries: &routeEntries,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error changing VPC route entries: vpc_id=%d, error: %w", vpcId, err)
+	}
+	if vpcResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code changing VPC route entries: vpc_id=%d, status=%s, details: %s",
+			vpcId, vpcResp.Status(), vpcResp.Body)
+	}
+
+	return &vpcResp.JSON200.Vpc.RouteEntries, nil
+}
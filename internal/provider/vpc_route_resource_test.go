@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVpcRouteResource(t *testing.T) {
+	vpc := `
+resource "binarylane_vpc" "test" {
+  name     = "tf-test-vpc-route"
+  ip_range = "10.240.0.0/16"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with both routes added to the same VPC concurrently
+			{
+				Config: providerConfig + vpc + `
+resource "binarylane_vpc_route" "vpn" {
+  vpc_id      = binarylane_vpc.test.id
+  description = "VPN"
+  destination = "192.168.1.0/24"
+  router      = "10.240.0.2"
+}
+
+resource "binarylane_vpc_route" "k8s" {
+  vpc_id      = binarylane_vpc.test.id
+  description = "Kubernetes"
+  destination = "10.96.0.0/12"
+  router      = "10.240.0.3"
+}
+
+data "binarylane_vpc_route_entries" "test" {
+  vpc_id = binarylane_vpc.test.id
+
+  depends_on = [binarylane_vpc_route.vpn, binarylane_vpc_route.k8s]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("binarylane_vpc_route.vpn", "vpc_id", "binarylane_vpc.test", "id"),
+					resource.TestCheckResourceAttr("binarylane_vpc_route.vpn", "destination", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr("binarylane_vpc_route.vpn", "router", "10.240.0.2"),
+					resource.TestCheckResourceAttr("binarylane_vpc_route.vpn", "description", "VPN"),
+					resource.TestCheckResourceAttr("binarylane_vpc_route.k8s", "destination", "10.96.0.0/12"),
+					resource.TestCheckResourceAttr("data.binarylane_vpc_route_entries.test", "route_entries.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "binarylane_vpc_route.vpn",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["binarylane_vpc_route.vpn"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_id"], rs.Primary.Attributes["destination"]), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "vpc_id",
+			},
+			// Update one route and remove the other
+			{
+				Config: providerConfig + vpc + `
+resource "binarylane_vpc_route" "vpn" {
+  vpc_id      = binarylane_vpc.test.id
+  description = "VPN (standby)"
+  destination = "192.168.1.0/24"
+  router      = "10.240.0.4"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("binarylane_vpc_route.vpn", "router", "10.240.0.4"),
+					resource.TestCheckResourceAttr("binarylane_vpc_route.vpn", "description", "VPN (standby)"),
+				),
+			},
+			// Remove the description
+			{
+				Config: providerConfig + vpc + `
+resource "binarylane_vpc_route" "vpn" {
+  vpc_id      = binarylane_vpc.test.id
+  destination = "192.168.1.0/24"
+  router      = "10.240.0.4"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("binarylane_vpc_route.vpn", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("binarylane_vpc_route.vpn", tfjsonpath.New("description"), knownvalue.Null()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("binarylane_vpc_route.vpn", "description"),
+				),
+			},
+			// Verify the remaining route entries of the VPC
+			{
+				Config: providerConfig + vpc + `
+resource "binarylane_vpc_route" "vpn" {
+  vpc_id      = binarylane_vpc.test.id
+  destination = "192.168.1.0/24"
+  router      = "10.240.0.4"
+}
+
+data "binarylane_vpc_route_entries" "test" {
+  vpc_id = binarylane_vpc.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.binarylane_vpc_route_entries.test", "route_entries.#", "1"),
+					resource.TestCheckResourceAttr("data.binarylane_vpc_route_entries.test", "route_entries.0.router", "10.240.0.4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}